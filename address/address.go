@@ -0,0 +1,108 @@
+// Package address converts the addresses of Teleport accounts between their bech32 form, e.g. "teleport1...",
+// and their ethereum form, a 0x prefixed hex string with the EIP-55 checksum. Both forms encode the same 20 bytes.
+// The bech32 prefixes are the ones set in the sdk config by the client package.
+package address
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	ErrInvalidAddress  = errors.New("invalid address")
+	ErrInvalidChecksum = errors.New("invalid EIP-55 checksum")
+)
+
+// IsHex reports whether addr is in the hex form, a 0x prefixed string of 40 hex digits.
+// It does not verify the checksum, see ValidateHex.
+func IsHex(addr string) bool {
+	return has0xPrefix(addr) && common.IsHexAddress(addr)
+}
+
+// ValidateHex checks that addr is in the hex form and that its EIP-55 checksum is valid.
+// An address in all lower or all upper case carries no checksum and is valid.
+func ValidateHex(addr string) error {
+	if !IsHex(addr) {
+		return fmt.Errorf("%w: %s is not a hex address", ErrInvalidAddress, addr)
+	}
+	digits := addr[2:]
+	if digits == strings.ToLower(digits) || digits == strings.ToUpper(digits) {
+		return nil
+	}
+	if common.HexToAddress(addr).Hex()[2:] != digits {
+		return fmt.Errorf("%w: %s", ErrInvalidChecksum, addr)
+	}
+	return nil
+}
+
+// AccAddress parses the account address addr in either form.
+func AccAddress(addr string) (sdk.AccAddress, error) {
+	if has0xPrefix(addr) {
+		if err := ValidateHex(addr); err != nil {
+			return nil, err
+		}
+		return common.HexToAddress(addr).Bytes(), nil
+	}
+	return sdk.AccAddressFromBech32(addr)
+}
+
+// ValAddress parses the validator operator address addr, either in its bech32 form, e.g. "teleportvaloper1...",
+// or in the hex form of the operator account.
+func ValAddress(addr string) (sdk.ValAddress, error) {
+	if has0xPrefix(addr) {
+		if err := ValidateHex(addr); err != nil {
+			return nil, err
+		}
+		return common.HexToAddress(addr).Bytes(), nil
+	}
+	return sdk.ValAddressFromBech32(addr)
+}
+
+// ToBech32 returns the bech32 form of the account address addr, given in either form.
+func ToBech32(addr string) (string, error) {
+	accAddr, err := AccAddress(addr)
+	if err != nil {
+		return "", err
+	}
+	return accAddr.String(), nil
+}
+
+// ToValBech32 returns the bech32 form of the validator operator address addr, given in either form.
+func ToValBech32(addr string) (string, error) {
+	valAddr, err := ValAddress(addr)
+	if err != nil {
+		return "", err
+	}
+	return valAddr.String(), nil
+}
+
+// ToHex returns the hex form with the EIP-55 checksum of addr, which is an account address in either form
+// or a bech32 validator operator address.
+func ToHex(addr string) (string, error) {
+	if has0xPrefix(addr) {
+		if err := ValidateHex(addr); err != nil {
+			return "", err
+		}
+		return common.HexToAddress(addr).Hex(), nil
+	}
+	if strings.HasPrefix(addr, sdk.GetConfig().GetBech32ValidatorAddrPrefix()) {
+		valAddr, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			return "", err
+		}
+		return common.BytesToAddress(valAddr).Hex(), nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return "", err
+	}
+	return common.BytesToAddress(accAddr).Hex(), nil
+}
+
+func has0xPrefix(addr string) bool {
+	return len(addr) >= 2 && addr[0] == '0' && (addr[1] == 'x' || addr[1] == 'X')
+}
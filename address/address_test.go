@@ -0,0 +1,50 @@
+package address_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/teleport-network/teleport-sdk-go/address"
+	_ "github.com/teleport-network/teleport-sdk-go/client" // teleport bech32 prefixes
+)
+
+const checksummed = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+func TestRoundTrip(t *testing.T) {
+	bech32, err := address.ToBech32(checksummed)
+	require.NoError(t, err)
+	require.Regexp(t, "^teleport1", bech32)
+
+	hex, err := address.ToHex(bech32)
+	require.NoError(t, err)
+	require.Equal(t, checksummed, hex)
+
+	valBech32, err := address.ToValBech32(checksummed)
+	require.NoError(t, err)
+	require.Regexp(t, "^teleportvaloper1", valBech32)
+	hex, err = address.ToHex(valBech32)
+	require.NoError(t, err)
+	require.Equal(t, checksummed, hex)
+
+	same, err := address.ToBech32(bech32)
+	require.NoError(t, err)
+	require.Equal(t, bech32, same)
+}
+
+func TestValidateHex(t *testing.T) {
+	require.NoError(t, address.ValidateHex(checksummed))
+	require.NoError(t, address.ValidateHex("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"))
+	require.NoError(t, address.ValidateHex("0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED"))
+
+	err := address.ValidateHex("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD")
+	require.True(t, errors.Is(err, address.ErrInvalidChecksum))
+	_, err = address.AccAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD")
+	require.True(t, errors.Is(err, address.ErrInvalidChecksum))
+
+	require.True(t, errors.Is(address.ValidateHex("0x5aAeb6053F"), address.ErrInvalidAddress))
+	require.False(t, address.IsHex("teleport1..."))
+	_, err = address.AccAddress("teleport1invalid")
+	require.Error(t, err)
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"github.com/teleport-network/teleport-sdk-go/address"
+)
+
+// toBech32 replaces each account address, given in either the bech32 or the hex form, by its bech32 form.
+func toBech32(addrs ...*string) error {
+	for _, addr := range addrs {
+		bech32, err := address.ToBech32(*addr)
+		if err != nil {
+			return err
+		}
+		*addr = bech32
+	}
+	return nil
+}
+
+// toValBech32 replaces each validator operator address, given in either the bech32 or the hex form, by its bech32 form.
+func toValBech32(addrs ...*string) error {
+	for _, addr := range addrs {
+		bech32, err := address.ToValBech32(*addr)
+		if err != nil {
+			return err
+		}
+		*addr = bech32
+	}
+	return nil
+}
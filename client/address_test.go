@@ -0,0 +1,59 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/teleport-network/teleport-sdk-go/address"
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+func TestToBech32(t *testing.T) {
+	_, from := newOfflineClient(t)
+	hex := common.BytesToAddress(from).Hex()
+
+	addr, other := hex, from.String()
+	require.NoError(t, toBech32(&addr, &other))
+	require.Equal(t, from.String(), addr)
+	require.Equal(t, from.String(), other)
+
+	valAddr := hex
+	require.NoError(t, toValBech32(&valAddr))
+	require.Regexp(t, "^teleportvaloper1", valAddr)
+
+	c, _ := newOfflineClient(t)
+	_, err := c.GetAccount("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD")
+	require.True(t, errors.Is(err, address.ErrInvalidChecksum))
+}
+
+func TestHexAddresses(t *testing.T) {
+	c, from := newOfflineClient(t)
+	hex := common.BytesToAddress(from).Hex()
+	const badChecksum = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"
+
+	// every entry point taking an address parses it in either form
+	_, _, err := c.DelegationTotalRewards(badChecksum)
+	require.ErrorIs(t, err, address.ErrInvalidChecksum)
+	_, err = c.ValidatorCommission(badChecksum)
+	require.ErrorIs(t, err, address.ErrInvalidChecksum)
+	_, err = c.Allowances(badChecksum)
+	require.ErrorIs(t, err, address.ErrInvalidChecksum)
+	_, err = c.Proposals(govtypes.StatusNil, badChecksum, "")
+	require.ErrorIs(t, err, address.ErrInvalidChecksum)
+	_, err = c.UnifiedBalance(badChecksum, "atele")
+	require.ErrorIs(t, err, address.ErrInvalidChecksum)
+	_, err = NewTextProposal("title", "description", nil, badChecksum)
+	require.ErrorIs(t, err, address.ErrInvalidChecksum)
+
+	// a hex sender of an ethereum tx signs with the key of its bech32 address
+	evmQuery := &fakeEVMQuery{}
+	c.EVMQuery = evmQuery
+	to := common.HexToAddress("0x01")
+	_, err = c.SendEthTx(hex, EthTx{To: &to})
+	require.ErrorIs(t, err, types.ErrUnavailable)
+	require.Equal(t, common.BytesToAddress(from), *evmQuery.args.From)
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// GetAccount queries the account of the given address, in the bech32 or the hex form, from the node, bypassing the account cache.
+func (client *TeleportClient) GetAccount(address string) (authtypes.AccountI, error) {
+	return client.GetAccountCtx(context.Background(), address)
+}
+
+func (client *TeleportClient) GetAccountCtx(ctx context.Context, address string) (authtypes.AccountI, error) {
+	if err := toBech32(&address); err != nil {
+		return nil, err
+	}
+	res, err := client.AuthQuery.Account(ctx, &authtypes.QueryAccountRequest{Address: address})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+
+	var acc authtypes.AccountI
+	if err := client.ctx.InterfaceRegistry.UnpackAny(res.Account, &acc); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/teleport-network/teleport-sdk-go/address"
+	"github.com/teleport-network/teleport-sdk-go/grpc"
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// Grant broadcasts msg, signed by the granter.
+func (client *TeleportClient) Grant(msg authz.MsgGrant, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.GrantCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) GrantCtx(ctx context.Context, msg authz.MsgGrant, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.Granter, &msg.Grantee); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// GrantGeneric grants grantee to execute any msg of the given type url, e.g. sdk.MsgTypeURL(&govtypes.MsgVote{}),
+// on behalf of granter until expiration.
+func (client *TeleportClient) GrantGeneric(granter, grantee, msgTypeURL string, expiration time.Time, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.GrantGenericCtx(context.Background(), granter, grantee, msgTypeURL, expiration, options...)
+}
+
+func (client *TeleportClient) GrantGenericCtx(ctx context.Context, granter, grantee, msgTypeURL string, expiration time.Time, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.grantCtx(ctx, granter, grantee, authz.NewGenericAuthorization(msgTypeURL), expiration, options...)
+}
+
+// GrantSend grants grantee to send up to spendLimit on behalf of granter until expiration.
+func (client *TeleportClient) GrantSend(granter, grantee string, spendLimit sdk.Coins, expiration time.Time, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.GrantSendCtx(context.Background(), granter, grantee, spendLimit, expiration, options...)
+}
+
+func (client *TeleportClient) GrantSendCtx(ctx context.Context, granter, grantee string, spendLimit sdk.Coins, expiration time.Time, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.grantCtx(ctx, granter, grantee, banktypes.NewSendAuthorization(spendLimit), expiration, options...)
+}
+
+// GrantStake grants grantee to delegate, undelegate or redelegate, as given by authzType, up to maxTokens
+// on behalf of granter until expiration. A nil maxTokens is unlimited. Either the allowed or the denied
+// validators may be given.
+func (client *TeleportClient) GrantStake(granter, grantee string, authzType stakingtypes.AuthorizationType, maxTokens *sdk.Coin, allowed, denied []sdk.ValAddress, expiration time.Time, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.GrantStakeCtx(context.Background(), granter, grantee, authzType, maxTokens, allowed, denied, expiration, options...)
+}
+
+func (client *TeleportClient) GrantStakeCtx(ctx context.Context, granter, grantee string, authzType stakingtypes.AuthorizationType, maxTokens *sdk.Coin, allowed, denied []sdk.ValAddress, expiration time.Time, options ...Option) (*tx.BroadcastTxResponse, error) {
+	authorization, err := stakingtypes.NewStakeAuthorization(allowed, denied, authzType, maxTokens)
+	if err != nil {
+		return nil, err
+	}
+	return client.grantCtx(ctx, granter, grantee, authorization, expiration, options...)
+}
+
+func (client *TeleportClient) grantCtx(ctx context.Context, granter, grantee string, authorization authz.Authorization, expiration time.Time, options ...Option) (*tx.BroadcastTxResponse, error) {
+	granterAddr, err := address.AccAddress(granter)
+	if err != nil {
+		return nil, err
+	}
+	granteeAddr, err := address.AccAddress(grantee)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := authz.NewMsgGrant(granterAddr, granteeAddr, authorization, expiration)
+	if err != nil {
+		return nil, err
+	}
+	return client.GrantCtx(ctx, *msg, options...)
+}
+
+// Revoke broadcasts msg, signed by the granter.
+func (client *TeleportClient) Revoke(msg authz.MsgRevoke, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.RevokeCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) RevokeCtx(ctx context.Context, msg authz.MsgRevoke, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.Granter, &msg.Grantee); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// Exec executes msgs on behalf of their signers, who granted grantee to do so. The tx is signed by grantee only,
+// so only its key has to be in the keyring.
+func (client *TeleportClient) Exec(grantee string, msgs []sdk.Msg, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.ExecCtx(context.Background(), grantee, msgs, options...)
+}
+
+func (client *TeleportClient) ExecCtx(ctx context.Context, grantee string, msgs []sdk.Msg, options ...Option) (*tx.BroadcastTxResponse, error) {
+	granteeAddr, err := address.AccAddress(grantee)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	msg := authz.NewMsgExec(granteeAddr, msgs)
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// Grants queries the grants of granter to grantee, through all pages.
+// A non empty msgTypeURL only queries the grants for that msg type.
+func (client *TeleportClient) Grants(granter, grantee, msgTypeURL string) ([]*authz.Grant, error) {
+	return client.GrantsCtx(context.Background(), granter, grantee, msgTypeURL)
+}
+
+func (client *TeleportClient) GrantsCtx(ctx context.Context, granter, grantee, msgTypeURL string) ([]*authz.Grant, error) {
+	if err := toBech32(&granter); err != nil {
+		return nil, err
+	}
+	if err := toBech32(&grantee); err != nil {
+		return nil, err
+	}
+	var grants []*authz.Grant
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.AuthzQuery.Grants(ctx, &authz.QueryGrantsRequest{Granter: granter, Grantee: grantee, MsgTypeUrl: msgTypeURL, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		grants = append(grants, res.Grants...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// unpack the authorizations for Grant.GetAuthorization
+	for _, grant := range grants {
+		if err := grant.UnpackInterfaces(client.ctx.InterfaceRegistry); err != nil {
+			return nil, err
+		}
+	}
+	return grants, nil
+}
+
+// GranterGrants queries the grants given by granter, through all pages.
+func (client *TeleportClient) GranterGrants(granter string) ([]*authz.GrantAuthorization, error) {
+	return client.GranterGrantsCtx(context.Background(), granter)
+}
+
+func (client *TeleportClient) GranterGrantsCtx(ctx context.Context, granter string) ([]*authz.GrantAuthorization, error) {
+	if err := toBech32(&granter); err != nil {
+		return nil, err
+	}
+	var grants []*authz.GrantAuthorization
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.AuthzQuery.GranterGrants(ctx, &authz.QueryGranterGrantsRequest{Granter: granter, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		grants = append(grants, res.Grants...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client.unpackGrantAuthorizations(grants)
+}
+
+// GranteeGrants queries the grants given to grantee, through all pages.
+func (client *TeleportClient) GranteeGrants(grantee string) ([]*authz.GrantAuthorization, error) {
+	return client.GranteeGrantsCtx(context.Background(), grantee)
+}
+
+func (client *TeleportClient) GranteeGrantsCtx(ctx context.Context, grantee string) ([]*authz.GrantAuthorization, error) {
+	if err := toBech32(&grantee); err != nil {
+		return nil, err
+	}
+	var grants []*authz.GrantAuthorization
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.AuthzQuery.GranteeGrants(ctx, &authz.QueryGranteeGrantsRequest{Grantee: grantee, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		grants = append(grants, res.Grants...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client.unpackGrantAuthorizations(grants)
+}
+
+func (client *TeleportClient) unpackGrantAuthorizations(grants []*authz.GrantAuthorization) ([]*authz.GrantAuthorization, error) {
+	for _, grant := range grants {
+		if err := grant.UnpackInterfaces(client.ctx.InterfaceRegistry); err != nil {
+			return nil, err
+		}
+	}
+	return grants, nil
+}
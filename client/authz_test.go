@@ -0,0 +1,36 @@
+package client
+
+import (
+	"testing"
+
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignExec(t *testing.T) {
+	c, grantee := newOfflineClient(t)
+	// the granter key is not in the keyring
+	granter := sdk.AccAddress("granter_____________")
+	send := banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atele", 1)))
+
+	msg := authz.NewMsgExec(grantee, []sdk.Msg{send})
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+
+	txf, err := Prepare(c, msg.GetSigners()[0], &msg, func(txf sdktx.Factory) sdktx.Factory {
+		return txf.WithGas(200000).WithAccountNumber(1).WithSequence(1)
+	})
+	require.NoError(t, err)
+	txBytes, err := c.SignTx(txf, &msg)
+	require.NoError(t, err)
+
+	decoded, err := c.ctx.TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	require.Len(t, decoded.GetMsgs(), 1)
+	exec := decoded.GetMsgs()[0].(*authz.MsgExec)
+	msgs, err := exec.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+}
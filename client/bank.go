@@ -1,14 +1,164 @@
 package client
 
 import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/tx"
-	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/teleport-network/teleport-sdk-go/grpc"
+	"github.com/teleport-network/teleport-sdk-go/types"
 )
 
-func (client *TeleportClient) Send(msg types.MsgSend, options ...Option) (*tx.BroadcastTxResponse, error) {
+func (client *TeleportClient) Send(msg banktypes.MsgSend, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.SendCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) SendCtx(ctx context.Context, msg banktypes.MsgSend, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.FromAddress, &msg.ToAddress); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+func (client *TeleportClient) MultiSend(msg banktypes.MsgMultiSend, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.MultiSendCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) MultiSendCtx(ctx context.Context, msg banktypes.MsgMultiSend, options ...Option) (*tx.BroadcastTxResponse, error) {
+	for i := range msg.Inputs {
+		if err := toBech32(&msg.Inputs[i].Address); err != nil {
+			return nil, err
+		}
+	}
+	for i := range msg.Outputs {
+		if err := toBech32(&msg.Outputs[i].Address); err != nil {
+			return nil, err
+		}
+	}
 	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
 	if err != nil {
 		return nil, err
 	}
-	return client.Broadcast(txf, &msg)
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// Balance queries the balance of the given address in denom.
+func (client *TeleportClient) Balance(address, denom string) (sdk.Coin, error) {
+	return client.BalanceCtx(context.Background(), address, denom)
+}
+
+func (client *TeleportClient) BalanceCtx(ctx context.Context, address, denom string) (sdk.Coin, error) {
+	if err := toBech32(&address); err != nil {
+		return sdk.Coin{}, err
+	}
+	res, err := client.BankQuery.Balance(ctx, &banktypes.QueryBalanceRequest{Address: address, Denom: denom})
+	if err != nil {
+		return sdk.Coin{}, types.WrapNodeError(err)
+	}
+	if res.Balance == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+	return *res.Balance, nil
+}
+
+// AllBalances queries the balances of the given address in all denoms, through all pages.
+func (client *TeleportClient) AllBalances(address string) (sdk.Coins, error) {
+	return client.AllBalancesCtx(context.Background(), address)
+}
+
+func (client *TeleportClient) AllBalancesCtx(ctx context.Context, address string) (sdk.Coins, error) {
+	if err := toBech32(&address); err != nil {
+		return nil, err
+	}
+	var balances sdk.Coins
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.BankQuery.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: address, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		balances = append(balances, res.Balances...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewCoins(balances...), nil
+}
+
+// SpendableBalances queries the balances of the given address which are not locked by vesting, through all pages.
+func (client *TeleportClient) SpendableBalances(address string) (sdk.Coins, error) {
+	return client.SpendableBalancesCtx(context.Background(), address)
+}
+
+func (client *TeleportClient) SpendableBalancesCtx(ctx context.Context, address string) (sdk.Coins, error) {
+	if err := toBech32(&address); err != nil {
+		return nil, err
+	}
+	var balances sdk.Coins
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.BankQuery.SpendableBalances(ctx, &banktypes.QuerySpendableBalancesRequest{Address: address, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		balances = append(balances, res.Balances...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewCoins(balances...), nil
+}
+
+// TotalSupply queries the total supply of all denoms, through all pages.
+func (client *TeleportClient) TotalSupply() (sdk.Coins, error) {
+	return client.TotalSupplyCtx(context.Background())
+}
+
+func (client *TeleportClient) TotalSupplyCtx(ctx context.Context) (sdk.Coins, error) {
+	var supply sdk.Coins
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.BankQuery.TotalSupply(ctx, &banktypes.QueryTotalSupplyRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		supply = append(supply, res.Supply...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewCoins(supply...), nil
+}
+
+// SupplyOf queries the total supply of denom.
+func (client *TeleportClient) SupplyOf(denom string) (sdk.Coin, error) {
+	return client.SupplyOfCtx(context.Background(), denom)
+}
+
+func (client *TeleportClient) SupplyOfCtx(ctx context.Context, denom string) (sdk.Coin, error) {
+	res, err := client.BankQuery.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	if err != nil {
+		return sdk.Coin{}, types.WrapNodeError(err)
+	}
+	return res.Amount, nil
+}
+
+// DenomMetadata queries the metadata of denom.
+func (client *TeleportClient) DenomMetadata(denom string) (banktypes.Metadata, error) {
+	return client.DenomMetadataCtx(context.Background(), denom)
+}
+
+func (client *TeleportClient) DenomMetadataCtx(ctx context.Context, denom string) (banktypes.Metadata, error) {
+	res, err := client.BankQuery.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+	if err != nil {
+		return banktypes.Metadata{}, types.WrapNodeError(err)
+	}
+	return res.Metadata, nil
 }
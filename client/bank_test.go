@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakePageSize is the number of items in a page served by the fake query clients.
+const fakePageSize = 2
+
+// page returns the bounds of the page of pageReq in n items, and its response. The key of a page is the index of
+// its first item.
+func page(pageReq *query.PageRequest, n int) (int, int, *query.PageResponse) {
+	start := 0
+	if len(pageReq.Key) > 0 {
+		start = int(pageReq.Key[0])
+	}
+	end := start + fakePageSize
+	if end >= n {
+		return start, n, &query.PageResponse{}
+	}
+	return start, end, &query.PageResponse{NextKey: []byte{byte(end)}}
+}
+
+// fakeBankQuery serves coins in pages and records the address and the number of pages queried.
+type fakeBankQuery struct {
+	banktypes.QueryClient
+
+	coins   sdk.Coins
+	address string
+	pages   int
+}
+
+func (q *fakeBankQuery) AllBalances(_ context.Context, req *banktypes.QueryAllBalancesRequest, _ ...grpc.CallOption) (*banktypes.QueryAllBalancesResponse, error) {
+	q.address = req.Address
+	q.pages++
+	start, end, pageRes := page(req.Pagination, len(q.coins))
+	return &banktypes.QueryAllBalancesResponse{Balances: q.coins[start:end], Pagination: pageRes}, nil
+}
+
+func (q *fakeBankQuery) SpendableBalances(_ context.Context, req *banktypes.QuerySpendableBalancesRequest, _ ...grpc.CallOption) (*banktypes.QuerySpendableBalancesResponse, error) {
+	q.address = req.Address
+	q.pages++
+	start, end, pageRes := page(req.Pagination, len(q.coins))
+	return &banktypes.QuerySpendableBalancesResponse{Balances: q.coins[start:end], Pagination: pageRes}, nil
+}
+
+func (q *fakeBankQuery) TotalSupply(_ context.Context, req *banktypes.QueryTotalSupplyRequest, _ ...grpc.CallOption) (*banktypes.QueryTotalSupplyResponse, error) {
+	q.pages++
+	start, end, pageRes := page(req.Pagination, len(q.coins))
+	return &banktypes.QueryTotalSupplyResponse{Supply: q.coins[start:end], Pagination: pageRes}, nil
+}
+
+func (q *fakeBankQuery) Balance(_ context.Context, req *banktypes.QueryBalanceRequest, _ ...grpc.CallOption) (*banktypes.QueryBalanceResponse, error) {
+	q.address = req.Address
+	coin := sdk.NewCoin(req.Denom, q.coins.AmountOf(req.Denom))
+	return &banktypes.QueryBalanceResponse{Balance: &coin}, nil
+}
+
+func TestBankQueries(t *testing.T) {
+	c, from := newOfflineClient(t)
+	coins := sdk.NewCoins(
+		sdk.NewInt64Coin("aaa", 1), sdk.NewInt64Coin("atele", 2), sdk.NewInt64Coin("bbb", 3),
+		sdk.NewInt64Coin("ccc", 4), sdk.NewInt64Coin("ddd", 5),
+	)
+	bankQuery := &fakeBankQuery{coins: coins}
+	c.BankQuery = bankQuery
+	hex := common.BytesToAddress(from).Hex()
+
+	balances, err := c.AllBalances(hex)
+	require.NoError(t, err)
+	require.Equal(t, coins, balances)
+	require.Equal(t, 3, bankQuery.pages)
+	require.Equal(t, from.String(), bankQuery.address)
+
+	bankQuery.pages, bankQuery.address = 0, ""
+	balances, err = c.SpendableBalances(hex)
+	require.NoError(t, err)
+	require.Equal(t, coins, balances)
+	require.Equal(t, 3, bankQuery.pages)
+	require.Equal(t, from.String(), bankQuery.address)
+
+	bankQuery.pages = 0
+	supply, err := c.TotalSupply()
+	require.NoError(t, err)
+	require.Equal(t, coins, supply)
+	require.Equal(t, 3, bankQuery.pages)
+
+	balance, err := c.Balance(hex, "atele")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("atele", 2), balance)
+	require.Equal(t, from.String(), bankQuery.address)
+}
+
+func TestSendHexAddresses(t *testing.T) {
+	c, from, txClient := newBroadcastClient(t)
+	to := sdk.AccAddress("to__________________")
+	hexFrom, hexTo := common.BytesToAddress(from).Hex(), common.BytesToAddress(to).Hex()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("atele", 5))
+
+	_, err := c.Send(banktypes.MsgSend{FromAddress: hexFrom, ToAddress: hexTo, Amount: amount}, fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{banktypes.NewMsgSend(from, to, amount)}, txClient.lastMsgs(t, c))
+
+	_, err = c.MultiSend(banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Address: hexFrom, Coins: amount.Add(amount...)}},
+		Outputs: []banktypes.Output{{Address: hexTo, Coins: amount}, {Address: to.String(), Coins: amount}},
+	}, fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(from, amount.Add(amount...))},
+		[]banktypes.Output{banktypes.NewOutput(to, amount), banktypes.NewOutput(to, amount)},
+	)}, txClient.lastMsgs(t, c))
+}
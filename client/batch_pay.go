@@ -0,0 +1,220 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/teleport-network/teleport-sdk-go/address"
+)
+
+const (
+	// signatureSize is the size of a secp256k1 signature, missing from the simulated txs measured by BatchPay.
+	signatureSize = 65
+	// sizeMargin bounds the error of the estimated size of a batch, which misses the growth of the length
+	// prefixes of the messages nesting the MsgMultiSend.
+	sizeMargin = 32
+)
+
+// Payment is a transfer of Amount to the recipient Address.
+type Payment struct {
+	Address string
+	Amount  sdk.Coins
+}
+
+// PaymentResult reports the tx paying a recipient.
+type PaymentResult struct {
+	Payment
+	// TxHash is the hash of the tx including the payment, empty if the tx was not broadcast.
+	TxHash string
+	// Err is the error of the tx including the payment, nil if the tx was accepted by the node.
+	Err error
+}
+
+// BatchConfig bounds the txs BatchPay splits the payments into. A zero bound is unbounded.
+type BatchConfig struct {
+	// MaxGas is the gas budget of a tx, checked by simulation.
+	MaxGas uint64
+	// MaxBytes is the size budget of a signed tx.
+	MaxBytes int
+	// MaxRecipients is the number of recipients of a tx.
+	MaxRecipients int
+}
+
+var DefaultBatchConfig = BatchConfig{
+	MaxGas:   2000000,
+	MaxBytes: 100000,
+}
+
+// BatchPay pays the payments from the given address, split into as few txs as the budgets of cfg allow:
+// a MsgSend for a single recipient and a MsgMultiSend otherwise. The txs are broadcast one after
+// the other, each with the next sequence of the sender, and a failed tx does not stop the following ones.
+// The result of each payment is returned in the order of payments.
+// The options apply to every tx, so fees are better left to gas prices or automatic fees.
+func (client *TeleportClient) BatchPay(from string, payments []Payment, cfg BatchConfig, options ...Option) ([]PaymentResult, error) {
+	return client.BatchPayCtx(context.Background(), from, payments, cfg, options...)
+}
+
+func (client *TeleportClient) BatchPayCtx(ctx context.Context, from string, payments []Payment, cfg BatchConfig, options ...Option) ([]PaymentResult, error) {
+	fromAddr, err := address.AccAddress(from)
+	if err != nil {
+		return nil, err
+	}
+	if len(payments) == 0 {
+		return nil, errors.New("no payment to send")
+	}
+	results := make([]PaymentResult, len(payments))
+	for i, p := range payments {
+		if _, err := address.AccAddress(p.Address); err != nil {
+			return nil, fmt.Errorf("payment %d: %w", i, err)
+		}
+		if !p.Amount.IsValid() || p.Amount.IsZero() {
+			return nil, fmt.Errorf("payment %d: invalid amount %s", i, p.Amount)
+		}
+		results[i].Payment = p
+	}
+
+	txf, err := Prepare(client, fromAddr, paymentMsg(fromAddr, payments), options...)
+	if err != nil {
+		return nil, err
+	}
+	batches, err := client.splitPayments(txf, fromAddr, payments, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, batch := range batches {
+		client.payBatch(ctx, txf, fromAddr, cfg, payments, batch, results)
+	}
+	return results, nil
+}
+
+// splitPayments splits the payments into batches of payment indexes, each under the size and recipient
+// budgets of cfg. The size of a batch is estimated by adding the encoded size of each payment to the size
+// of its tx, which is measured on the simulated tx only when the estimate gets near the budget.
+func (client *TeleportClient) splitPayments(txf sdktx.Factory, from sdk.AccAddress, payments []Payment, cfg BatchConfig) ([][]int, error) {
+	signers, err := client.txSigners(banktypes.NewMsgSend(from, from, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var batches [][]int
+	var batch []int
+	var total sdk.Coins // the amount paid by batch
+	var size int        // the estimated size of the tx of batch
+	for i, p := range payments {
+		if cfg.MaxRecipients > 0 && len(batch) == cfg.MaxRecipients {
+			batches, batch = append(batches, batch), nil
+		}
+		if cfg.MaxBytes > 0 && len(batch) > 0 {
+			next := total.Add(p.Amount...)
+			size += paymentSize(from, total, next, p)
+			// the tx of a single payment is a MsgSend, so its size does not carry over to a MsgMultiSend
+			if len(batch) == 1 || size+sizeMargin > cfg.MaxBytes {
+				size, err = client.batchSize(txf, signers, from, payments, append(batch, i))
+				if err != nil {
+					return nil, err
+				}
+			}
+			if size > cfg.MaxBytes {
+				batches, batch = append(batches, batch), nil
+			}
+		}
+		if len(batch) == 0 {
+			total = nil
+		}
+		batch = append(batch, i)
+		total = total.Add(p.Amount...)
+	}
+	return append(batches, batch), nil
+}
+
+func (client *TeleportClient) batchSize(txf sdktx.Factory, signers []txSigner, from sdk.AccAddress, payments []Payment, batch []int) (int, error) {
+	txBytes, err := client.buildSimTx(txf, signers, paymentMsg(from, batchPayments(payments, batch)))
+	if err != nil {
+		return 0, err
+	}
+	return len(txBytes) + len(signers)*signatureSize, nil
+}
+
+// paymentSize returns the growth of a MsgMultiSend paying total from the given address when it pays p as well,
+// which adds an output and raises the input to next.
+func paymentSize(from sdk.AccAddress, total, next sdk.Coins, p Payment) int {
+	to, _ := address.AccAddress(p.Address)
+	output := banktypes.NewOutput(to, p.Amount)
+	input, nextInput := banktypes.NewInput(from, total), banktypes.NewInput(from, next)
+	return fieldSize(output.Size()) + fieldSize(nextInput.Size()) - fieldSize(input.Size())
+}
+
+// fieldSize returns the encoded size of a protobuf message field of n bytes, with its tag and length prefix.
+func fieldSize(n int) int {
+	var buf [binary.MaxVarintLen64]byte
+	return 1 + binary.PutUvarint(buf[:], uint64(n)) + n
+}
+
+// payBatch broadcasts the payments of batch in a tx and reports it in results.
+// A batch exceeding the gas budget is split in halves.
+func (client *TeleportClient) payBatch(ctx context.Context, txf sdktx.Factory, from sdk.AccAddress, cfg BatchConfig, payments []Payment, batch []int, results []PaymentResult) {
+	msg := paymentMsg(from, batchPayments(payments, batch))
+
+	if cfg.MaxGas > 0 && txf.SimulateAndExecute() {
+		_, gas, err := client.CalculateGasCtx(ctx, txf, msg)
+		if err != nil {
+			reportBatch(results, batch, "", err)
+			return
+		}
+		if gas > cfg.MaxGas {
+			if len(batch) > 1 {
+				client.payBatch(ctx, txf, from, cfg, payments, batch[:len(batch)/2], results)
+				client.payBatch(ctx, txf, from, cfg, payments, batch[len(batch)/2:], results)
+				return
+			}
+			reportBatch(results, batch, "", fmt.Errorf("gas %d exceeds the budget %d", gas, cfg.MaxGas))
+			return
+		}
+		txf = txf.WithGas(gas).WithSimulateAndExecute(false)
+	}
+
+	res, err := client.BroadcastCtx(ctx, txf, msg)
+	var txHash string
+	if res != nil && res.TxResponse != nil {
+		txHash = res.TxResponse.TxHash
+	}
+	reportBatch(results, batch, txHash, err)
+}
+
+func reportBatch(results []PaymentResult, batch []int, txHash string, err error) {
+	for _, i := range batch {
+		results[i].TxHash, results[i].Err = txHash, err
+	}
+}
+
+func batchPayments(payments []Payment, batch []int) []Payment {
+	selected := make([]Payment, len(batch))
+	for i, j := range batch {
+		selected[i] = payments[j]
+	}
+	return selected
+}
+
+// paymentMsg returns the msg paying the payments from the given address.
+func paymentMsg(from sdk.AccAddress, payments []Payment) sdk.Msg {
+	if len(payments) == 1 {
+		to, _ := address.AccAddress(payments[0].Address)
+		return banktypes.NewMsgSend(from, to, payments[0].Amount)
+	}
+
+	total := sdk.NewCoins()
+	outputs := make([]banktypes.Output, len(payments))
+	for i, p := range payments {
+		to, _ := address.AccAddress(p.Address)
+		total = total.Add(p.Amount...)
+		outputs[i] = banktypes.NewOutput(to, p.Amount)
+	}
+	return banktypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(from, total)}, outputs)
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitPayments(t *testing.T) {
+	c, from := newOfflineClient(t)
+	payments := make([]Payment, 10)
+	for i := range payments {
+		payments[i] = Payment{Address: from.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("atele", int64(i+1)))}
+	}
+	txf := c.NewFactory()
+
+	batches, err := c.splitPayments(txf, from, payments, BatchConfig{MaxRecipients: 4})
+	require.NoError(t, err)
+	require.Equal(t, [][]int{{0, 1, 2, 3}, {4, 5, 6, 7}, {8, 9}}, batches)
+
+	signers, err := c.txSigners(banktypes.NewMsgSend(from, from, nil))
+	require.NoError(t, err)
+	size, err := c.batchSize(txf, signers, from, payments, []int{0, 1, 2})
+	require.NoError(t, err)
+
+	batches, err = c.splitPayments(txf, from, payments, BatchConfig{MaxBytes: size})
+	require.NoError(t, err)
+	var paid []int
+	for _, batch := range batches {
+		require.LessOrEqual(t, len(batch), 3)
+		batchSize, err := c.batchSize(txf, signers, from, payments, batch)
+		require.NoError(t, err)
+		require.LessOrEqual(t, batchSize, size)
+		paid = append(paid, batch...)
+	}
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, paid)
+
+	// a single payment over the budget still gets its own batch
+	batches, err = c.splitPayments(txf, from, payments[:2], BatchConfig{MaxBytes: 1})
+	require.NoError(t, err)
+	require.Equal(t, [][]int{{0}, {1}}, batches)
+}
+
+func TestPaymentSize(t *testing.T) {
+	c, from := newOfflineClient(t)
+	payments := make([]Payment, 300)
+	for i := range payments {
+		payments[i] = Payment{Address: from.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("atele", int64(i+1)*1000000))}
+	}
+	txf := c.NewFactory()
+	signers, err := c.txSigners(banktypes.NewMsgSend(from, from, nil))
+	require.NoError(t, err)
+
+	size, err := c.batchSize(txf, signers, from, payments, []int{0, 1})
+	require.NoError(t, err)
+	total := payments[0].Amount.Add(payments[1].Amount...)
+	batch := []int{0, 1}
+	for i := 2; i < len(payments); i++ {
+		next := total.Add(payments[i].Amount...)
+		size += paymentSize(from, total, next, payments[i])
+		total, batch = next, append(batch, i)
+
+		measured, err := c.batchSize(txf, signers, from, payments, batch)
+		require.NoError(t, err)
+		require.LessOrEqual(t, size, measured)
+		require.Less(t, measured-size, sizeMargin)
+	}
+}
+
+func TestPaymentMsg(t *testing.T) {
+	from := sdk.AccAddress("from________________")
+	to1, to2 := sdk.AccAddress("to1_________________"), sdk.AccAddress("to2_________________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("atele", 5))
+
+	msg := paymentMsg(from, []Payment{{Address: to1.String(), Amount: amount}})
+	require.Equal(t, banktypes.NewMsgSend(from, to1, amount), msg)
+
+	msg = paymentMsg(from, []Payment{{Address: to1.String(), Amount: amount}, {Address: to2.String(), Amount: amount}})
+	require.NoError(t, msg.ValidateBasic())
+	multiSend := msg.(*banktypes.MsgMultiSend)
+	require.Equal(t, amount.Add(amount...), multiSend.Inputs[0].Coins)
+	require.Len(t, multiSend.Outputs, 2)
+
+	msg = paymentMsg(from, []Payment{{Address: common.BytesToAddress(to1).Hex(), Amount: amount}})
+	require.Equal(t, banktypes.NewMsgSend(from, to1, amount), msg)
+}
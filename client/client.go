@@ -31,6 +31,10 @@ type TeleportClient struct {
 	ctx sdkclient.Context
 
 	accountRetriever *types.AccountRetriever
+	sequences        *types.SequenceManager
+	waitConfig       WaitConfig
+	retry            RetryPolicy
+	feeConfig        *FeeConfig
 }
 
 func NewClient(url string, chainId string) (*TeleportClient, error) {
@@ -47,6 +51,7 @@ func NewClient(url string, chainId string) (*TeleportClient, error) {
 	}
 
 	accountCache := common.NewCache(1000, true)
+	accountRetriever := &types.AccountRetriever{QueryClient: grpcClient, Cache: accountCache}
 	ctx := sdkclient.Context{}.
 		WithCodec(encodingConfig.Marshaler).
 		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
@@ -59,7 +64,10 @@ func NewClient(url string, chainId string) (*TeleportClient, error) {
 	return &TeleportClient{
 		ctx:              ctx,
 		GClient:          grpcClient,
-		accountRetriever: &types.AccountRetriever{QueryClient: grpcClient, Cache: accountCache},
+		accountRetriever: accountRetriever,
+		sequences:        types.NewSequenceManager(accountRetriever),
+		waitConfig:       DefaultWaitConfig,
+		retry:            DefaultRetryPolicy,
 	}, nil
 }
 
@@ -87,12 +95,28 @@ func (client *TeleportClient) WithBroadcastMode(mode string) *TeleportClient {
 	return client
 }
 
+func (client *TeleportClient) WithWaitConfig(cfg WaitConfig) *TeleportClient {
+	client.waitConfig = cfg
+	return client
+}
+
+func (client *TeleportClient) WithRetryPolicy(policy RetryPolicy) *TeleportClient {
+	client.retry = policy
+	return client
+}
+
+func (client *TeleportClient) GetSequenceManager() *types.SequenceManager {
+	return client.sequences
+}
+
 func (client *TeleportClient) DisableCache() {
 	client.accountRetriever.Cache.Disable()
+	client.sequences.Disable()
 }
 
 func (client *TeleportClient) EnableCache() {
 	client.accountRetriever.Cache.Enable()
+	client.sequences.Enable()
 }
 
 func (client *TeleportClient) Key(name string) (string, error) {
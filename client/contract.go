@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// Contract binds the ABI of the contract deployed at Address to the client, like the bound contracts of
+// abigen: constant methods are called with EthCall, other methods are sent in MsgEthereumTx signed by a key
+// of the keyring, and the event logs of its txs are decoded by the ABI.
+type Contract struct {
+	client  *TeleportClient
+	Address common.Address
+	ABI     abi.ABI
+}
+
+// BindContract returns the Contract at address with the given ABI.
+func (client *TeleportClient) BindContract(address common.Address, contractABI abi.ABI) *Contract {
+	return &Contract{client: client, Address: address, ABI: contractABI}
+}
+
+// NewContract returns the Contract at address with the ABI in JSON.
+func (client *TeleportClient) NewContract(address common.Address, abiJSON string) (*Contract, error) {
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+	return client.BindContract(address, contractABI), nil
+}
+
+// DeployContractWithABI deploys a contract of the ABI with the creation code bytecode and the constructor args,
+// signed by from with the fields of opts, see SendEthTx, and returns the Contract at its address.
+func (client *TeleportClient) DeployContractWithABI(from string, contractABI abi.ABI, bytecode []byte, opts EthTx, args ...interface{}) (*Contract, *EthTxResponse, error) {
+	return client.DeployContractWithABICtx(context.Background(), from, contractABI, bytecode, opts, args...)
+}
+
+func (client *TeleportClient) DeployContractWithABICtx(ctx context.Context, from string, contractABI abi.ABI, bytecode []byte, opts EthTx, args ...interface{}) (*Contract, *EthTxResponse, error) {
+	input, err := contractABI.Pack("", args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts.To = nil
+	opts.Data = append(append([]byte{}, bytecode...), input...)
+	res, err := client.DeployContractCtx(ctx, from, opts)
+	if err != nil {
+		return nil, res, err
+	}
+	return client.BindContract(*res.ContractAddress, contractABI), res, nil
+}
+
+// Call calls the constant method with args and returns its decoded outputs.
+func (c *Contract) Call(method string, args ...interface{}) ([]interface{}, error) {
+	return c.CallCtx(context.Background(), method, args...)
+}
+
+func (c *Contract) CallCtx(ctx context.Context, method string, args ...interface{}) ([]interface{}, error) {
+	return c.client.CallContractCtx(ctx, c.ABI, c.Address, method, args...)
+}
+
+// Transact sends a tx calling method with args, signed by from with the fields of opts, see SendEthTx.
+// The recipient and the data of opts are set by the contract.
+func (c *Contract) Transact(from string, opts EthTx, method string, args ...interface{}) (*EthTxResponse, error) {
+	return c.TransactCtx(context.Background(), from, opts, method, args...)
+}
+
+func (c *Contract) TransactCtx(ctx context.Context, from string, opts EthTx, method string, args ...interface{}) (*EthTxResponse, error) {
+	input, err := c.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	opts.To = &c.Address
+	opts.Data = input
+	return c.client.SendEthTxCtx(ctx, from, opts)
+}
+
+// UnpackLog decodes the log of event into out, a pointer to a struct with a field per event argument,
+// like the generated event types of abigen.
+func (c *Contract) UnpackLog(out interface{}, event string, log ethtypes.Log) error {
+	indexed, err := c.indexedArgs(event, log)
+	if err != nil {
+		return err
+	}
+	if len(log.Data) > 0 {
+		if err := c.ABI.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return err
+		}
+	}
+	return abi.ParseTopics(out, indexed, log.Topics[1:])
+}
+
+// UnpackLogIntoMap decodes the log of event into out, keyed by the event argument names.
+func (c *Contract) UnpackLogIntoMap(out map[string]interface{}, event string, log ethtypes.Log) error {
+	indexed, err := c.indexedArgs(event, log)
+	if err != nil {
+		return err
+	}
+	if len(log.Data) > 0 {
+		if err := c.ABI.UnpackIntoMap(out, event, log.Data); err != nil {
+			return err
+		}
+	}
+	return abi.ParseTopicsIntoMap(out, indexed, log.Topics[1:])
+}
+
+// indexedArgs checks that log is an event of the given name and returns the indexed arguments of the event.
+func (c *Contract) indexedArgs(event string, log ethtypes.Log) (abi.Arguments, error) {
+	abiEvent, ok := c.ABI.Events[event]
+	if !ok {
+		return nil, fmt.Errorf("no event %s in the contract ABI", event)
+	}
+	if len(log.Topics) == 0 || log.Topics[0] != abiEvent.ID {
+		return nil, fmt.Errorf("log is not a %s event", event)
+	}
+	var indexed abi.Arguments
+	for _, arg := range abiEvent.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	return indexed, nil
+}
+
+// Events decodes the logs of event emitted by the contract in the committed tx of result.
+func (c *Contract) Events(result *TxResult, event string) ([]map[string]interface{}, error) {
+	if _, ok := c.ABI.Events[event]; !ok {
+		return nil, fmt.Errorf("no event %s in the contract ABI", event)
+	}
+	logs, err := result.EthLogs()
+	if err != nil {
+		return nil, err
+	}
+
+	var events []map[string]interface{}
+	for _, log := range logs {
+		if log.Address != c.Address || len(log.Topics) == 0 || log.Topics[0] != c.ABI.Events[event].ID {
+			continue
+		}
+		out := make(map[string]interface{})
+		if err := c.UnpackLogIntoMap(out, event, *log); err != nil {
+			return nil, err
+		}
+		events = append(events, out)
+	}
+	return events, nil
+}
+
+// EthLogs returns the ethereum logs emitted by the ethereum txs of the result.
+func (r *TxResult) EthLogs() ([]*ethtypes.Log, error) {
+	var logs []*ethtypes.Log
+	for _, value := range r.Attributes(evmtypes.EventTypeTxLog, evmtypes.AttributeKeyTxLog) {
+		var log evmtypes.Log
+		if err := json.Unmarshal([]byte(value), &log); err != nil {
+			return nil, err
+		}
+		logs = append(logs, log.ToEthereum())
+	}
+	return logs, nil
+}
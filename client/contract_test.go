@@ -0,0 +1,61 @@
+package client
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+const transferABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+
+func TestContractEvents(t *testing.T) {
+	c, _ := newOfflineClient(t)
+	address := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+	contract, err := c.NewContract(address, transferABI)
+	require.NoError(t, err)
+
+	from, to := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	transfer := &ethtypes.Log{
+		Address: address,
+		Topics:  []common.Hash{contract.ABI.Events["Transfer"].ID, from.Hash(), to.Hash()},
+		Data:    common.BigToHash(big.NewInt(100)).Bytes(),
+	}
+	other := &ethtypes.Log{Address: common.HexToAddress("0x03"), Topics: transfer.Topics, Data: transfer.Data}
+
+	var attrs []sdk.Attribute
+	for _, log := range []*ethtypes.Log{transfer, other} {
+		bz, err := json.Marshal(evmtypes.NewLogFromEth(log))
+		require.NoError(t, err)
+		attrs = append(attrs, sdk.NewAttribute(evmtypes.AttributeKeyTxLog, string(bz)))
+	}
+	result := &TxResult{TxResponse: &sdk.TxResponse{Logs: sdk.ABCIMessageLogs{{
+		Events: sdk.StringifyEvents([]abci.Event{abci.Event(sdk.NewEvent(evmtypes.EventTypeTxLog, attrs...))}),
+	}}}}
+
+	logs, err := result.EthLogs()
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+
+	events, err := contract.Events(result, "Transfer")
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{{"from": from, "to": to, "value": big.NewInt(100)}}, events)
+
+	var out struct {
+		From  common.Address
+		To    common.Address
+		Value *big.Int
+	}
+	require.NoError(t, contract.UnpackLog(&out, "Transfer", *transfer))
+	require.Equal(t, to, out.To)
+	require.Equal(t, big.NewInt(100), out.Value)
+
+	_, err = contract.Events(result, "Approval")
+	require.Error(t, err)
+}
@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/teleport-network/teleport-sdk-go/address"
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+func (client *TeleportClient) WithdrawDelegatorReward(msg distrtypes.MsgWithdrawDelegatorReward, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.WithdrawDelegatorRewardCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) WithdrawDelegatorRewardCtx(ctx context.Context, msg distrtypes.MsgWithdrawDelegatorReward, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.DelegatorAddress); err != nil {
+		return nil, err
+	}
+	if err := toValBech32(&msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// WithdrawAllRewards withdraws the rewards of delegator from all the validators it delegates to, in a single tx.
+func (client *TeleportClient) WithdrawAllRewards(delegator string, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.WithdrawAllRewardsCtx(context.Background(), delegator, options...)
+}
+
+func (client *TeleportClient) WithdrawAllRewardsCtx(ctx context.Context, delegator string, options ...Option) (*tx.BroadcastTxResponse, error) {
+	delAddr, err := address.AccAddress(delegator)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.DistributionQuery.DelegatorValidators(ctx, &distrtypes.QueryDelegatorValidatorsRequest{DelegatorAddress: delAddr.String()})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	if len(res.Validators) == 0 {
+		return nil, errors.New("no delegation to withdraw rewards from")
+	}
+
+	msgs := make([]sdk.Msg, len(res.Validators))
+	for i, validator := range res.Validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return nil, err
+		}
+		msgs[i] = distrtypes.NewMsgWithdrawDelegatorReward(delAddr, valAddr)
+	}
+	return client.NewTxBuilder(options...).AddMsgs(msgs...).BroadcastCtx(ctx)
+}
+
+func (client *TeleportClient) WithdrawValidatorCommission(msg distrtypes.MsgWithdrawValidatorCommission, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.WithdrawValidatorCommissionCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) WithdrawValidatorCommissionCtx(ctx context.Context, msg distrtypes.MsgWithdrawValidatorCommission, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toValBech32(&msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+func (client *TeleportClient) SetWithdrawAddress(msg distrtypes.MsgSetWithdrawAddress, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.SetWithdrawAddressCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) SetWithdrawAddressCtx(ctx context.Context, msg distrtypes.MsgSetWithdrawAddress, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.DelegatorAddress, &msg.WithdrawAddress); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+func (client *TeleportClient) FundCommunityPool(msg distrtypes.MsgFundCommunityPool, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.FundCommunityPoolCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) FundCommunityPoolCtx(ctx context.Context, msg distrtypes.MsgFundCommunityPool, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.Depositor); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// DelegationRewards queries the pending rewards of delegator from validator.
+func (client *TeleportClient) DelegationRewards(delegator, validator string) (sdk.DecCoins, error) {
+	return client.DelegationRewardsCtx(context.Background(), delegator, validator)
+}
+
+func (client *TeleportClient) DelegationRewardsCtx(ctx context.Context, delegator, validator string) (sdk.DecCoins, error) {
+	if err := toBech32(&delegator); err != nil {
+		return nil, err
+	}
+	if err := toValBech32(&validator); err != nil {
+		return nil, err
+	}
+	res, err := client.DistributionQuery.DelegationRewards(ctx, &distrtypes.QueryDelegationRewardsRequest{DelegatorAddress: delegator, ValidatorAddress: validator})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	return res.Rewards, nil
+}
+
+// DelegationTotalRewards queries the pending rewards of delegator from each validator it delegates to, and their total.
+func (client *TeleportClient) DelegationTotalRewards(delegator string) ([]distrtypes.DelegationDelegatorReward, sdk.DecCoins, error) {
+	return client.DelegationTotalRewardsCtx(context.Background(), delegator)
+}
+
+func (client *TeleportClient) DelegationTotalRewardsCtx(ctx context.Context, delegator string) ([]distrtypes.DelegationDelegatorReward, sdk.DecCoins, error) {
+	if err := toBech32(&delegator); err != nil {
+		return nil, nil, err
+	}
+	res, err := client.DistributionQuery.DelegationTotalRewards(ctx, &distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: delegator})
+	if err != nil {
+		return nil, nil, types.WrapNodeError(err)
+	}
+	return res.Rewards, res.Total, nil
+}
+
+// ValidatorCommission queries the commission accumulated by validator and not withdrawn yet.
+func (client *TeleportClient) ValidatorCommission(validator string) (sdk.DecCoins, error) {
+	return client.ValidatorCommissionCtx(context.Background(), validator)
+}
+
+func (client *TeleportClient) ValidatorCommissionCtx(ctx context.Context, validator string) (sdk.DecCoins, error) {
+	if err := toValBech32(&validator); err != nil {
+		return nil, err
+	}
+	res, err := client.DistributionQuery.ValidatorCommission(ctx, &distrtypes.QueryValidatorCommissionRequest{ValidatorAddress: validator})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	return res.Commission.Commission, nil
+}
+
+// ValidatorOutstandingRewards queries the rewards of validator and its delegators not withdrawn yet.
+func (client *TeleportClient) ValidatorOutstandingRewards(validator string) (sdk.DecCoins, error) {
+	return client.ValidatorOutstandingRewardsCtx(context.Background(), validator)
+}
+
+func (client *TeleportClient) ValidatorOutstandingRewardsCtx(ctx context.Context, validator string) (sdk.DecCoins, error) {
+	if err := toValBech32(&validator); err != nil {
+		return nil, err
+	}
+	res, err := client.DistributionQuery.ValidatorOutstandingRewards(ctx, &distrtypes.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: validator})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	return res.Rewards.Rewards, nil
+}
+
+// WithdrawAddress queries the address the rewards of delegator are withdrawn to.
+func (client *TeleportClient) WithdrawAddress(delegator string) (string, error) {
+	return client.WithdrawAddressCtx(context.Background(), delegator)
+}
+
+func (client *TeleportClient) WithdrawAddressCtx(ctx context.Context, delegator string) (string, error) {
+	if err := toBech32(&delegator); err != nil {
+		return "", err
+	}
+	res, err := client.DistributionQuery.DelegatorWithdrawAddress(ctx, &distrtypes.QueryDelegatorWithdrawAddressRequest{DelegatorAddress: delegator})
+	if err != nil {
+		return "", types.WrapNodeError(err)
+	}
+	return res.WithdrawAddress, nil
+}
+
+func (client *TeleportClient) CommunityPool() (sdk.DecCoins, error) {
+	return client.CommunityPoolCtx(context.Background())
+}
+
+func (client *TeleportClient) CommunityPoolCtx(ctx context.Context) (sdk.DecCoins, error) {
+	res, err := client.DistributionQuery.CommunityPool(ctx, &distrtypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	return res.Pool, nil
+}
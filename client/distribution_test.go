@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+var (
+	val1 = sdk.ValAddress("val1________________")
+	val2 = sdk.ValAddress("val2________________")
+)
+
+// fakeDistrQuery serves the rewards of a delegator to validators, and records the addresses it is queried for.
+type fakeDistrQuery struct {
+	distrtypes.QueryClient
+
+	validators []string
+	delegator  string
+	validator  string
+}
+
+func (q *fakeDistrQuery) DelegatorValidators(_ context.Context, req *distrtypes.QueryDelegatorValidatorsRequest, _ ...grpc.CallOption) (*distrtypes.QueryDelegatorValidatorsResponse, error) {
+	q.delegator = req.DelegatorAddress
+	return &distrtypes.QueryDelegatorValidatorsResponse{Validators: q.validators}, nil
+}
+
+func (q *fakeDistrQuery) DelegationRewards(_ context.Context, req *distrtypes.QueryDelegationRewardsRequest, _ ...grpc.CallOption) (*distrtypes.QueryDelegationRewardsResponse, error) {
+	q.delegator, q.validator = req.DelegatorAddress, req.ValidatorAddress
+	return &distrtypes.QueryDelegationRewardsResponse{Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin("atele", 5))}, nil
+}
+
+func (q *fakeDistrQuery) DelegationTotalRewards(_ context.Context, req *distrtypes.QueryDelegationTotalRewardsRequest, _ ...grpc.CallOption) (*distrtypes.QueryDelegationTotalRewardsResponse, error) {
+	q.delegator = req.DelegatorAddress
+	reward := sdk.NewDecCoins(sdk.NewInt64DecCoin("atele", 5))
+	return &distrtypes.QueryDelegationTotalRewardsResponse{
+		Rewards: []distrtypes.DelegationDelegatorReward{
+			{ValidatorAddress: val1.String(), Reward: reward},
+			{ValidatorAddress: val2.String(), Reward: reward},
+		},
+		Total: reward.Add(reward...),
+	}, nil
+}
+
+func (q *fakeDistrQuery) ValidatorCommission(_ context.Context, req *distrtypes.QueryValidatorCommissionRequest, _ ...grpc.CallOption) (*distrtypes.QueryValidatorCommissionResponse, error) {
+	q.validator = req.ValidatorAddress
+	commission := distrtypes.ValidatorAccumulatedCommission{Commission: sdk.NewDecCoins(sdk.NewInt64DecCoin("atele", 3))}
+	return &distrtypes.QueryValidatorCommissionResponse{Commission: commission}, nil
+}
+
+func (q *fakeDistrQuery) DelegatorWithdrawAddress(_ context.Context, req *distrtypes.QueryDelegatorWithdrawAddressRequest, _ ...grpc.CallOption) (*distrtypes.QueryDelegatorWithdrawAddressResponse, error) {
+	q.delegator = req.DelegatorAddress
+	return &distrtypes.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: req.DelegatorAddress}, nil
+}
+
+func TestWithdrawAllRewards(t *testing.T) {
+	c, from, txClient := newBroadcastClient(t)
+	distrQuery := &fakeDistrQuery{validators: []string{val1.String(), val2.String()}}
+	c.DistributionQuery = distrQuery
+
+	_, err := c.WithdrawAllRewards(common.BytesToAddress(from).Hex(), fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, from.String(), distrQuery.delegator)
+	require.Equal(t, []sdk.Msg{
+		distrtypes.NewMsgWithdrawDelegatorReward(from, val1),
+		distrtypes.NewMsgWithdrawDelegatorReward(from, val2),
+	}, txClient.lastMsgs(t, c))
+
+	distrQuery.validators = nil
+	_, err = c.WithdrawAllRewards(from.String(), fixedFee)
+	require.EqualError(t, err, "no delegation to withdraw rewards from")
+	require.Equal(t, 1, txClient.broadcasts)
+}
+
+func TestDistributionMsgs(t *testing.T) {
+	c, from, txClient := newBroadcastClient(t)
+	hex := common.BytesToAddress(from).Hex()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("atele", 1))
+
+	_, err := c.WithdrawDelegatorReward(distrtypes.MsgWithdrawDelegatorReward{DelegatorAddress: hex, ValidatorAddress: val1.String()}, fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{distrtypes.NewMsgWithdrawDelegatorReward(from, val1)}, txClient.lastMsgs(t, c))
+
+	_, err = c.WithdrawValidatorCommission(distrtypes.MsgWithdrawValidatorCommission{ValidatorAddress: hex}, fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{distrtypes.NewMsgWithdrawValidatorCommission(sdk.ValAddress(from))}, txClient.lastMsgs(t, c))
+
+	_, err = c.SetWithdrawAddress(distrtypes.MsgSetWithdrawAddress{DelegatorAddress: hex, WithdrawAddress: hex}, fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{distrtypes.NewMsgSetWithdrawAddress(from, from)}, txClient.lastMsgs(t, c))
+
+	_, err = c.FundCommunityPool(distrtypes.MsgFundCommunityPool{Depositor: hex, Amount: amount}, fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{distrtypes.NewMsgFundCommunityPool(amount, from)}, txClient.lastMsgs(t, c))
+}
+
+func TestDistributionQueries(t *testing.T) {
+	c, from := newOfflineClient(t)
+	distrQuery := &fakeDistrQuery{}
+	c.DistributionQuery = distrQuery
+	hex := common.BytesToAddress(from).Hex()
+
+	rewards, err := c.DelegationRewards(hex, common.BytesToAddress(val1).Hex())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("atele", 5)), rewards)
+	require.Equal(t, from.String(), distrQuery.delegator)
+	require.Equal(t, val1.String(), distrQuery.validator)
+
+	rewardsByValidator, total, err := c.DelegationTotalRewards(hex)
+	require.NoError(t, err)
+	require.Len(t, rewardsByValidator, 2)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("atele", 10)), total)
+
+	commission, err := c.ValidatorCommission(val2.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("atele", 3)), commission)
+	require.Equal(t, val2.String(), distrQuery.validator)
+
+	withdrawAddress, err := c.WithdrawAddress(hex)
+	require.NoError(t, err)
+	require.Equal(t, from.String(), withdrawAddress)
+
+	_, err = c.DelegationRewards(from.String(), "teleportvaloper1invalid")
+	require.Error(t, err)
+}
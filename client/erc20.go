@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	aggregatetypes "github.com/teleport-network/teleport/x/aggregate/types"
+
+	"github.com/teleport-network/teleport-sdk-go/address"
+	"github.com/teleport-network/teleport-sdk-go/grpc"
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+const erc20BalanceOfABI = `[{"constant":true,"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"type":"function"}]`
+
+var erc20ABI abi.ABI
+
+func init() {
+	var err error
+	if erc20ABI, err = abi.JSON(strings.NewReader(erc20BalanceOfABI)); err != nil {
+		panic(err)
+	}
+}
+
+// ConvertCoin converts the native coin of a token pair into its ERC20 token, minted to the hex receiver.
+func (client *TeleportClient) ConvertCoin(msg aggregatetypes.MsgConvertCoin, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.ConvertCoinCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) ConvertCoinCtx(ctx context.Context, msg aggregatetypes.MsgConvertCoin, options ...Option) (*tx.BroadcastTxResponse, error) {
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// ConvertERC20 converts the ERC20 token of a token pair into its native coin of msg.Denom, sent to the bech32 receiver.
+// The hex sender of msg signs the tx with the key of its bech32 address.
+func (client *TeleportClient) ConvertERC20(msg aggregatetypes.MsgConvertERC20, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.ConvertERC20Ctx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) ConvertERC20Ctx(ctx context.Context, msg aggregatetypes.MsgConvertERC20, options ...Option) (*tx.BroadcastTxResponse, error) {
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// TokenPairs queries all the token pairs registered in the aggregate module, through all pages.
+func (client *TeleportClient) TokenPairs() ([]aggregatetypes.TokenPair, error) {
+	return client.TokenPairsCtx(context.Background())
+}
+
+func (client *TeleportClient) TokenPairsCtx(ctx context.Context) ([]aggregatetypes.TokenPair, error) {
+	var pairs []aggregatetypes.TokenPair
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.ERC20Query.TokenPairs(ctx, &aggregatetypes.QueryTokenPairsRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		pairs = append(pairs, res.TokenPairs...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// TokenPair queries the token pair of token, which is either a native denom or the hex address of an ERC20 contract.
+func (client *TeleportClient) TokenPair(token string) (aggregatetypes.TokenPair, error) {
+	return client.TokenPairCtx(context.Background(), token)
+}
+
+func (client *TeleportClient) TokenPairCtx(ctx context.Context, token string) (aggregatetypes.TokenPair, error) {
+	res, err := client.ERC20Query.TokenPair(ctx, &aggregatetypes.QueryTokenPairRequest{Token: token})
+	if err != nil {
+		return aggregatetypes.TokenPair{}, types.WrapNodeError(err)
+	}
+	return res.TokenPair, nil
+}
+
+// TokenPairByDenom queries the token pair of the native denom.
+func (client *TeleportClient) TokenPairByDenom(denom string) (aggregatetypes.TokenPair, error) {
+	return client.TokenPairByDenomCtx(context.Background(), denom)
+}
+
+func (client *TeleportClient) TokenPairByDenomCtx(ctx context.Context, denom string) (aggregatetypes.TokenPair, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return aggregatetypes.TokenPair{}, err
+	}
+	return client.TokenPairCtx(ctx, denom)
+}
+
+// TokenPairByContract queries the token pair of the ERC20 contract.
+func (client *TeleportClient) TokenPairByContract(contract common.Address) (aggregatetypes.TokenPair, error) {
+	return client.TokenPairByContractCtx(context.Background(), contract)
+}
+
+func (client *TeleportClient) TokenPairByContractCtx(ctx context.Context, contract common.Address) (aggregatetypes.TokenPair, error) {
+	return client.TokenPairCtx(ctx, contract.Hex())
+}
+
+// ERC20Params queries the parameters of the aggregate module.
+func (client *TeleportClient) ERC20Params() (aggregatetypes.Params, error) {
+	return client.ERC20ParamsCtx(context.Background())
+}
+
+func (client *TeleportClient) ERC20ParamsCtx(ctx context.Context) (aggregatetypes.Params, error) {
+	res, err := client.ERC20Query.Params(ctx, &aggregatetypes.QueryParamsRequest{})
+	if err != nil {
+		return aggregatetypes.Params{}, types.WrapNodeError(err)
+	}
+	return res.Params, nil
+}
+
+// TokenBalance is the balance of an account in a token pair, held both as native coins and as the ERC20 token.
+type TokenBalance struct {
+	TokenPair aggregatetypes.TokenPair
+	Coins     sdk.Coins
+	ERC20     sdk.Int
+}
+
+// Total returns the sum of the native coins and the ERC20 token, which are converted one to one.
+func (b TokenBalance) Total() sdk.Int {
+	total := b.ERC20
+	for _, coin := range b.Coins {
+		total = total.Add(coin.Amount)
+	}
+	return total
+}
+
+// UnifiedBalance queries the balance of account, in either address form, in the token pair of token, a native denom
+// or the hex address of an ERC20 contract: the balances of all the denoms of the pair and of its ERC20 token.
+func (client *TeleportClient) UnifiedBalance(account, token string) (*TokenBalance, error) {
+	return client.UnifiedBalanceCtx(context.Background(), account, token)
+}
+
+func (client *TeleportClient) UnifiedBalanceCtx(ctx context.Context, account, token string) (*TokenBalance, error) {
+	addr, err := address.AccAddress(account)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := client.TokenPairCtx(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var coins sdk.Coins
+	for _, denom := range pair.Denoms {
+		coin, err := client.BalanceCtx(ctx, addr.String(), denom)
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Add(coin)
+	}
+
+	outputs, err := client.CallContractCtx(ctx, erc20ABI, pair.GetERC20Contract(), "balanceOf", common.BytesToAddress(addr))
+	if err != nil {
+		return nil, err
+	}
+	if len(outputs) != 1 {
+		return nil, fmt.Errorf("balanceOf returned %d values", len(outputs))
+	}
+	balance, ok := outputs[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("balanceOf returned a %T", outputs[0])
+	}
+	return &TokenBalance{TokenPair: pair, Coins: coins, ERC20: sdk.NewIntFromBigInt(balance)}, nil
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	aggregatetypes "github.com/teleport-network/teleport/x/aggregate/types"
+)
+
+func TestConvertERC20Signer(t *testing.T) {
+	c, from := newOfflineClient(t)
+	contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+	msg := aggregatetypes.NewMsgConvertERC20(sdk.NewInt(10), from, contract, common.BytesToAddress(from), "atele")
+
+	// the hex sender resolves to the key of its bech32 address in the keyring
+	require.Equal(t, from, msg.GetSigners()[0])
+	_, err := Prepare(c, msg.GetSigners()[0], msg)
+	require.NoError(t, err)
+}
+
+func TestTokenBalanceTotal(t *testing.T) {
+	balance := TokenBalance{
+		Coins: sdk.NewCoins(sdk.NewCoin("atele", sdk.NewInt(3)), sdk.NewCoin("xibc/tele", sdk.NewInt(4))),
+		ERC20: sdk.NewInt(5),
+	}
+	require.Equal(t, sdk.NewInt(12), balance.Total())
+}
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/teleport-network/teleport-sdk-go/address"
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// defaultGasCap bounds the gas estimation of ethereum txs, like the default gas cap of the ethermint json-rpc.
+const defaultGasCap = 25000000
+
+// EthTx is an ethereum tx: a value transfer, a contract call or, without recipient, a contract deployment.
+type EthTx struct {
+	// Type is ethtypes.LegacyTxType, ethtypes.AccessListTxType or ethtypes.DynamicFeeTxType.
+	Type uint8
+	// To is the recipient or the called contract, nil to deploy a contract whose creation code is Data.
+	To    *common.Address
+	Value *big.Int
+	Data  []byte
+	// Gas is the gas limit, estimated by the node if 0.
+	Gas uint64
+	// GasPrice is the gas price of legacy and access list txs, suggested from the base fee if nil.
+	GasPrice *big.Int
+	// GasFeeCap is the max fee per gas of dynamic fee txs, suggested from the base fee if nil.
+	GasFeeCap *big.Int
+	// GasTipCap is the max priority fee per gas of dynamic fee txs, zero if nil.
+	GasTipCap *big.Int
+	// AccessList is the access list of access list and dynamic fee txs.
+	AccessList ethtypes.AccessList
+}
+
+// EthTxResponse is the response of the broadcast of an ethereum tx.
+type EthTxResponse struct {
+	*tx.BroadcastTxResponse
+	// Hash is the ethereum hash of the tx, unlike the tendermint hash of the TxResponse.
+	Hash  common.Hash
+	Nonce uint64
+	// ContractAddress is the address of the contract deployed by the tx, nil if it deploys none.
+	ContractAddress *common.Address
+}
+
+// SendEthTx signs ethTx with the eth_secp256k1 key of the sender in the keyring and broadcasts it in a MsgEthereumTx.
+// The nonce is the next sequence of the sender, managed like the sequences of cosmos txs, and the tx is retried
+// according to the retry policy of the client.
+func (client *TeleportClient) SendEthTx(from string, ethTx EthTx) (*EthTxResponse, error) {
+	return client.SendEthTxCtx(context.Background(), from, ethTx)
+}
+
+func (client *TeleportClient) SendEthTxCtx(ctx context.Context, from string, ethTx EthTx) (*EthTxResponse, error) {
+	fromAddr, err := address.AccAddress(from)
+	if err != nil {
+		return nil, err
+	}
+	if client.ctx.Keyring == nil {
+		return nil, errors.New("keyring must be imported")
+	}
+	if _, err := client.ctx.Keyring.KeyByAddress(fromAddr); err != nil {
+		return nil, err
+	}
+	chainID, err := ethermint.ParseChainID(client.ctx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	if ethTx, err = client.fillEthTx(ctx, fromAddr, ethTx); err != nil {
+		return nil, err
+	}
+	params, err := client.EVMQuery.Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+
+	var res *EthTxResponse
+	retryableFunc := func() error {
+		return client.sequences.Do(ctx, client.ctx, fromAddr, func(_, nonce uint64) (bool, error) {
+			msg, err := client.signEthTx(chainID, fromAddr, ethTx, nonce)
+			if err != nil {
+				return false, err
+			}
+			txBytes, err := client.encodeEthTx(msg, params.Params.EvmDenom)
+			if err != nil {
+				return false, err
+			}
+			broadcastRes, err := client.broadcastTx(ctx, client.ctx.BroadcastMode, txBytes)
+			if err != nil {
+				return false, err
+			}
+
+			res = &EthTxResponse{BroadcastTxResponse: broadcastRes, Hash: common.HexToHash(msg.Hash), Nonce: nonce}
+			if ethTx.To == nil {
+				contract := crypto.CreateAddress(common.BytesToAddress(fromAddr), nonce)
+				res.ContractAddress = &contract
+			}
+			if broadcastRes.TxResponse.Code != 0 {
+				return broadcastRes.TxResponse.Height > 0, types.NewTxError(broadcastRes.TxResponse)
+			}
+			return true, nil
+		})
+	}
+
+	err = client.retryPolicy(ctx).do(ctx, retryableFunc)
+	return res, err
+}
+
+// DeployContract deploys the contract whose creation code is ethTx.Data, see SendEthTx.
+// The address of the contract is returned in the ContractAddress of the response.
+func (client *TeleportClient) DeployContract(from string, ethTx EthTx) (*EthTxResponse, error) {
+	return client.DeployContractCtx(context.Background(), from, ethTx)
+}
+
+func (client *TeleportClient) DeployContractCtx(ctx context.Context, from string, ethTx EthTx) (*EthTxResponse, error) {
+	if ethTx.To != nil {
+		return nil, errors.New("contract deployment must not have a recipient")
+	}
+	if len(ethTx.Data) == 0 {
+		return nil, errors.New("contract deployment must have creation code")
+	}
+	return client.SendEthTxCtx(ctx, from, ethTx)
+}
+
+// fillEthTx estimates the gas limit and suggests the fees of ethTx where they are not set.
+func (client *TeleportClient) fillEthTx(ctx context.Context, from sdk.AccAddress, ethTx EthTx) (EthTx, error) {
+	if ethTx.Gas == 0 {
+		gas, err := client.EstimateGasCtx(ctx, ethereum.CallMsg{
+			From:       common.BytesToAddress(from),
+			To:         ethTx.To,
+			Value:      ethTx.Value,
+			Data:       ethTx.Data,
+			AccessList: ethTx.AccessList,
+		})
+		if err != nil {
+			return EthTx{}, err
+		}
+		ethTx.Gas = gas
+	}
+
+	suggest := func() (*big.Int, error) {
+		gasPrice, err := client.SuggestGasPriceCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return gasPrice.Amount.Ceil().TruncateInt().BigInt(), nil
+	}
+	var err error
+	switch ethTx.Type {
+	case ethtypes.LegacyTxType, ethtypes.AccessListTxType:
+		if ethTx.GasPrice == nil {
+			ethTx.GasPrice, err = suggest()
+		}
+	case ethtypes.DynamicFeeTxType:
+		if ethTx.GasFeeCap == nil {
+			ethTx.GasFeeCap, err = suggest()
+		}
+		if ethTx.GasTipCap == nil {
+			ethTx.GasTipCap = big.NewInt(0)
+		}
+	default:
+		err = fmt.Errorf("unknown ethereum tx type %d", ethTx.Type)
+	}
+	return ethTx, err
+}
+
+// signEthTx returns the MsgEthereumTx of ethTx with the given nonce, signed by the key of from.
+func (client *TeleportClient) signEthTx(chainID *big.Int, from sdk.AccAddress, ethTx EthTx, nonce uint64) (*evmtypes.MsgEthereumTx, error) {
+	var txData ethtypes.TxData
+	switch ethTx.Type {
+	case ethtypes.LegacyTxType:
+		txData = &ethtypes.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: ethTx.GasPrice,
+			Gas:      ethTx.Gas,
+			To:       ethTx.To,
+			Value:    ethTx.Value,
+			Data:     ethTx.Data,
+		}
+	case ethtypes.AccessListTxType:
+		txData = &ethtypes.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      nonce,
+			GasPrice:   ethTx.GasPrice,
+			Gas:        ethTx.Gas,
+			To:         ethTx.To,
+			Value:      ethTx.Value,
+			Data:       ethTx.Data,
+			AccessList: ethTx.AccessList,
+		}
+	case ethtypes.DynamicFeeTxType:
+		txData = &ethtypes.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      nonce,
+			GasTipCap:  ethTx.GasTipCap,
+			GasFeeCap:  ethTx.GasFeeCap,
+			Gas:        ethTx.Gas,
+			To:         ethTx.To,
+			Value:      ethTx.Value,
+			Data:       ethTx.Data,
+			AccessList: ethTx.AccessList,
+		}
+	default:
+		return nil, fmt.Errorf("unknown ethereum tx type %d", ethTx.Type)
+	}
+
+	msg := &evmtypes.MsgEthereumTx{}
+	if err := msg.FromEthereumTx(ethtypes.NewTx(txData)); err != nil {
+		return nil, err
+	}
+	msg.From = common.BytesToAddress(from).Hex()
+	if err := msg.Sign(ethtypes.LatestSignerForChainID(chainID), client.ctx.Keyring); err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// encodeEthTx encodes the cosmos tx carrying msg, whose fees are paid in evmDenom.
+func (client *TeleportClient) encodeEthTx(msg *evmtypes.MsgEthereumTx, evmDenom string) ([]byte, error) {
+	cosmosTx, err := msg.BuildTx(client.ctx.TxConfig.NewTxBuilder(), evmDenom)
+	if err != nil {
+		return nil, err
+	}
+	return client.ctx.TxConfig.TxEncoder()(cosmosTx)
+}
@@ -0,0 +1,239 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// RevertError is the error of a reverted eth call, with the reason given by the contract if any.
+type RevertError struct {
+	Reason string
+	// Data is the revert data returned by the contract.
+	Data []byte
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return vm.ErrExecutionReverted.Error()
+	}
+	return fmt.Sprintf("%s: %s", vm.ErrExecutionReverted, e.Reason)
+}
+
+// EthBalance queries the balance of address in the evm denom.
+func (client *TeleportClient) EthBalance(address common.Address) (*big.Int, error) {
+	return client.EthBalanceCtx(context.Background(), address)
+}
+
+func (client *TeleportClient) EthBalanceCtx(ctx context.Context, address common.Address) (*big.Int, error) {
+	res, err := client.EVMQuery.Balance(ctx, &evmtypes.QueryBalanceRequest{Address: address.Hex()})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	balance, ok := new(big.Int).SetString(res.Balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance %s", res.Balance)
+	}
+	return balance, nil
+}
+
+// EthCode queries the code of the contract at address, empty if there is none.
+func (client *TeleportClient) EthCode(address common.Address) ([]byte, error) {
+	return client.EthCodeCtx(context.Background(), address)
+}
+
+func (client *TeleportClient) EthCodeCtx(ctx context.Context, address common.Address) ([]byte, error) {
+	res, err := client.EVMQuery.Code(ctx, &evmtypes.QueryCodeRequest{Address: address.Hex()})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	return res.Code, nil
+}
+
+// EthStorage queries the value of the storage slot key of the contract at address.
+func (client *TeleportClient) EthStorage(address common.Address, key common.Hash) (common.Hash, error) {
+	return client.EthStorageCtx(context.Background(), address, key)
+}
+
+func (client *TeleportClient) EthStorageCtx(ctx context.Context, address common.Address, key common.Hash) (common.Hash, error) {
+	res, err := client.EVMQuery.Storage(ctx, &evmtypes.QueryStorageRequest{Address: address.Hex(), Key: key.Hex()})
+	if err != nil {
+		return common.Hash{}, types.WrapNodeError(err)
+	}
+	return common.HexToHash(res.Value), nil
+}
+
+// EthAccount queries the balance, the code hash and the nonce of address.
+func (client *TeleportClient) EthAccount(address common.Address) (*evmtypes.QueryAccountResponse, error) {
+	return client.EthAccountCtx(context.Background(), address)
+}
+
+func (client *TeleportClient) EthAccountCtx(ctx context.Context, address common.Address) (*evmtypes.QueryAccountResponse, error) {
+	res, err := client.EVMQuery.Account(ctx, &evmtypes.QueryAccountRequest{Address: address.Hex()})
+	return res, types.WrapNodeError(err)
+}
+
+// EthCall executes call, whose Data is the ABI-encoded input, on the latest state without creating a tx,
+// and returns the ABI-encoded output. A reverted call returns a *RevertError.
+func (client *TeleportClient) EthCall(call ethereum.CallMsg) ([]byte, error) {
+	return client.EthCallCtx(context.Background(), call)
+}
+
+func (client *TeleportClient) EthCallCtx(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	args, err := callArgs(call)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.EVMQuery.EthCall(ctx, &evmtypes.EthCallRequest{Args: args, GasCap: defaultGasCap})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	if res.VmError == vm.ErrExecutionReverted.Error() {
+		reason, _ := abi.UnpackRevert(res.Ret)
+		return nil, &RevertError{Reason: reason, Data: res.Ret}
+	}
+	if res.Failed() {
+		return nil, errors.New(res.VmError)
+	}
+	return res.Ret, nil
+}
+
+// CallContract calls method of the contract at address with args encoded by contractABI, and returns the decoded outputs.
+func (client *TeleportClient) CallContract(contractABI abi.ABI, address common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	return client.CallContractCtx(context.Background(), contractABI, address, method, args...)
+}
+
+func (client *TeleportClient) CallContractCtx(ctx context.Context, contractABI abi.ABI, address common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	output, err := client.EthCallCtx(ctx, ethereum.CallMsg{To: &address, Data: input})
+	if err != nil {
+		return nil, err
+	}
+	return contractABI.Unpack(method, output)
+}
+
+// EstimateGas estimates the gas limit of call as a tx.
+func (client *TeleportClient) EstimateGas(call ethereum.CallMsg) (uint64, error) {
+	return client.EstimateGasCtx(context.Background(), call)
+}
+
+func (client *TeleportClient) EstimateGasCtx(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	args, err := callArgs(call)
+	if err != nil {
+		return 0, err
+	}
+	res, err := client.EVMQuery.EstimateGas(ctx, &evmtypes.EthCallRequest{Args: args, GasCap: defaultGasCap})
+	if err != nil {
+		return 0, types.WrapNodeError(err)
+	}
+	return res.Gas, nil
+}
+
+// TraceTx replays the committed ethereum tx of the given hash with the tracer of config, nil for the default
+// struct logger, and returns the trace as json.
+func (client *TeleportClient) TraceTx(hash common.Hash, config *evmtypes.TraceConfig) (json.RawMessage, error) {
+	return client.TraceTxCtx(context.Background(), hash, config)
+}
+
+func (client *TeleportClient) TraceTxCtx(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) (json.RawMessage, error) {
+	txsRes, err := client.GetTxsEventCtx(ctx, &tx.GetTxsEventRequest{
+		Events: []string{fmt.Sprintf("%s.%s='%s'", evmtypes.EventTypeEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())},
+	})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	if len(txsRes.TxResponses) == 0 {
+		return nil, fmt.Errorf("ethereum tx %s not found", hash.Hex())
+	}
+	height := txsRes.TxResponses[0].Height
+	txHash, err := hex.DecodeString(txsRes.TxResponses[0].TxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blockRes, err := client.TMServiceQuery.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: height})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+
+	// the ethereum msgs executed before the traced one in the block are replayed first
+	var predecessors []*evmtypes.MsgEthereumTx
+	for i, txBytes := range blockRes.Block.Data.Txs {
+		decoded, err := client.ctx.TxConfig.TxDecoder()(txBytes)
+		if err != nil {
+			continue
+		}
+		isTraced := bytes.Equal(tmhash.Sum(txBytes), txHash)
+		for _, msg := range decoded.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			if isTraced && ethMsg.Hash == hash.Hex() {
+				res, err := client.EVMQuery.TraceTx(ctx, &evmtypes.QueryTraceTxRequest{
+					Msg:          ethMsg,
+					TxIndex:      uint64(i),
+					TraceConfig:  config,
+					Predecessors: predecessors,
+					BlockNumber:  height,
+					BlockHash:    common.Bytes2Hex(blockRes.BlockId.Hash),
+					BlockTime:    blockRes.Block.Header.Time,
+				})
+				if err != nil {
+					return nil, types.WrapNodeError(err)
+				}
+				return res.Data, nil
+			}
+			predecessors = append(predecessors, ethMsg)
+		}
+	}
+	return nil, fmt.Errorf("ethereum tx %s not found in block %d", hash.Hex(), height)
+}
+
+// callArgs encodes call as the json args of the evm queries.
+func callArgs(call ethereum.CallMsg) ([]byte, error) {
+	data := hexutil.Bytes(call.Data)
+	args := evmtypes.TransactionArgs{To: call.To, Data: &data}
+	if call.From != (common.Address{}) {
+		args.From = &call.From
+	}
+	if call.Gas != 0 {
+		gas := hexutil.Uint64(call.Gas)
+		args.Gas = &gas
+	}
+	if call.GasPrice != nil {
+		args.GasPrice = (*hexutil.Big)(call.GasPrice)
+	}
+	if call.GasFeeCap != nil {
+		args.MaxFeePerGas = (*hexutil.Big)(call.GasFeeCap)
+	}
+	if call.GasTipCap != nil {
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(call.GasTipCap)
+	}
+	if call.Value != nil {
+		args.Value = (*hexutil.Big)(call.Value)
+	}
+	if call.AccessList != nil {
+		args.AccessList = &call.AccessList
+	}
+	return json.Marshal(args)
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func TestSignEthTx(t *testing.T) {
+	c, from := newOfflineClient(t)
+	chainID, err := ethermint.ParseChainID(c.ctx.ChainID)
+	require.NoError(t, err)
+	to := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+
+	for _, ethTx := range []EthTx{
+		{Type: ethtypes.LegacyTxType, To: &to, Value: big.NewInt(1), Gas: 21000, GasPrice: big.NewInt(10)},
+		{Type: ethtypes.AccessListTxType, To: &to, Data: []byte{1, 2}, Gas: 50000, GasPrice: big.NewInt(10)},
+		{Type: ethtypes.DynamicFeeTxType, Data: []byte{1, 2}, Gas: 50000, GasFeeCap: big.NewInt(10), GasTipCap: big.NewInt(1)},
+	} {
+		msg, err := c.signEthTx(chainID, from, ethTx, 7)
+		require.NoError(t, err)
+
+		signed := msg.AsTransaction()
+		require.Equal(t, ethTx.Type, signed.Type())
+		require.EqualValues(t, 7, signed.Nonce())
+		require.Equal(t, ethTx.To, signed.To())
+		sender, err := ethtypes.LatestSignerForChainID(chainID).Sender(signed)
+		require.NoError(t, err)
+		require.Equal(t, common.BytesToAddress(from), sender)
+
+		txBytes, err := c.encodeEthTx(msg, "atele")
+		require.NoError(t, err)
+		decoded, err := c.ctx.TxConfig.TxDecoder()(txBytes)
+		require.NoError(t, err)
+		require.Len(t, decoded.GetMsgs(), 1)
+		require.Equal(t, msg.Hash, decoded.GetMsgs()[0].(*evmtypes.MsgEthereumTx).Hash)
+	}
+
+	_, err = c.signEthTx(chainID, from, EthTx{Type: 3}, 0)
+	require.Error(t, err)
+}
+
+func TestCallArgs(t *testing.T) {
+	to := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+	args, err := callArgs(ethereum.CallMsg{To: &to, Value: big.NewInt(16), Data: []byte{0xab}})
+	require.NoError(t, err)
+
+	var decoded evmtypes.TransactionArgs
+	require.NoError(t, json.Unmarshal(args, &decoded))
+	require.Nil(t, decoded.From)
+	require.Equal(t, to, *decoded.To)
+	require.Equal(t, big.NewInt(16), decoded.Value.ToInt())
+	require.Equal(t, []byte{0xab}, decoded.GetData())
+}
+
+func TestRevertError(t *testing.T) {
+	require.Equal(t, "execution reverted", (&RevertError{}).Error())
+	require.Equal(t, "execution reverted: not owner", (&RevertError{Reason: "not owner"}).Error())
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// FeeConfig defines how the fees of a tx are set when its options give neither fees nor gas prices.
+// The gas price is the base fee of the feemarket module raised to MinGasPrice, scaled by Multiplier
+// and capped at MaxGasPrice, and the fees are the gas price times the gas limit of the tx.
+type FeeConfig struct {
+	// Denom is the denom of the fees.
+	Denom string
+	// Multiplier scales the gas price, to absorb the increase of the base fee until the tx is included.
+	Multiplier sdk.Dec
+	// MinGasPrice is the lowest gas price before scaling, also used when the base fee is disabled.
+	MinGasPrice sdk.Dec
+	// MaxGasPrice caps the gas price against base fee spikes, nil means no cap.
+	MaxGasPrice sdk.Dec
+}
+
+// withDefaults returns the config with its missing fields taken from DefaultFeeConfig.
+func (cfg FeeConfig) withDefaults() FeeConfig {
+	if cfg.Denom == "" {
+		cfg.Denom = DefaultFeeConfig.Denom
+	}
+	if cfg.Multiplier.IsNil() {
+		cfg.Multiplier = DefaultFeeConfig.Multiplier
+	}
+	if cfg.MinGasPrice.IsNil() {
+		cfg.MinGasPrice = DefaultFeeConfig.MinGasPrice
+	}
+	return cfg
+}
+
+// Validate checks the denom of the config and that its prices and multiplier are not negative.
+func (cfg FeeConfig) Validate() error {
+	if err := sdk.ValidateDenom(cfg.Denom); err != nil {
+		return err
+	}
+	if !cfg.Multiplier.IsNil() && cfg.Multiplier.IsNegative() {
+		return fmt.Errorf("negative gas price multiplier %s", cfg.Multiplier)
+	}
+	if !cfg.MinGasPrice.IsNil() && cfg.MinGasPrice.IsNegative() {
+		return fmt.Errorf("negative min gas price %s", cfg.MinGasPrice)
+	}
+	if !cfg.MaxGasPrice.IsNil() && cfg.MaxGasPrice.IsNegative() {
+		return fmt.Errorf("negative max gas price %s", cfg.MaxGasPrice)
+	}
+	return nil
+}
+
+// DefaultFeeConfig pays the base fee in atele with a 20% margin.
+var DefaultFeeConfig = FeeConfig{
+	Denom:       "atele",
+	Multiplier:  sdk.NewDecWithPrec(12, 1),
+	MinGasPrice: sdk.ZeroDec(),
+}
+
+// WithAutoFee makes the client set the fees of the txs which have neither fees nor gas prices.
+// The missing fields of cfg are taken from DefaultFeeConfig, and an invalid config is rejected.
+func (client *TeleportClient) WithAutoFee(cfg FeeConfig) error {
+	cfg = cfg.withDefaults()
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	client.feeConfig = &cfg
+	return nil
+}
+
+func (client *TeleportClient) DisableAutoFee() {
+	client.feeConfig = nil
+}
+
+// SuggestGasPrice returns the gas price the client pays by its FeeConfig, or by DefaultFeeConfig
+// if automatic fees are disabled.
+func (client *TeleportClient) SuggestGasPrice() (sdk.DecCoin, error) {
+	return client.SuggestGasPriceCtx(context.Background())
+}
+
+func (client *TeleportClient) SuggestGasPriceCtx(ctx context.Context) (sdk.DecCoin, error) {
+	cfg := DefaultFeeConfig
+	if client.feeConfig != nil {
+		cfg = *client.feeConfig
+	}
+
+	cfg = cfg.withDefaults()
+	price := cfg.MinGasPrice
+
+	res, err := client.FeeMarketQuery.BaseFee(ctx, &feemarkettypes.QueryBaseFeeRequest{})
+	if err != nil {
+		return sdk.DecCoin{}, types.WrapNodeError(err)
+	}
+	// the base fee is nil if it is disabled
+	if res.BaseFee != nil {
+		if baseFee := sdk.NewDecFromInt(*res.BaseFee); baseFee.GT(price) {
+			price = baseFee
+		}
+	}
+	price = price.Mul(cfg.Multiplier)
+	if !cfg.MaxGasPrice.IsNil() && price.GT(cfg.MaxGasPrice) {
+		price = cfg.MaxGasPrice
+	}
+	gasPrice := sdk.DecCoin{Denom: cfg.Denom, Amount: price}
+	if err := gasPrice.Validate(); err != nil {
+		return sdk.DecCoin{}, err
+	}
+	return gasPrice, nil
+}
+
+// fees returns the fees of gas at the gas price.
+func fees(gasPrice sdk.DecCoin, gas uint64) sdk.Coin {
+	amount := gasPrice.Amount.Mul(sdk.NewDecFromInt(sdk.NewIntFromUint64(gas))).Ceil().TruncateInt()
+	return sdk.NewCoin(gasPrice.Denom, amount)
+}
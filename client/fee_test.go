@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// fakeFeeMarketQuery serves baseFee, nil when the base fee is disabled, or fails if err is set.
+type fakeFeeMarketQuery struct {
+	feemarkettypes.QueryClient
+
+	baseFee *sdk.Int
+	err     error
+}
+
+func (q fakeFeeMarketQuery) BaseFee(context.Context, *feemarkettypes.QueryBaseFeeRequest, ...grpc.CallOption) (*feemarkettypes.QueryBaseFeeResponse, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &feemarkettypes.QueryBaseFeeResponse{BaseFee: q.baseFee}, nil
+}
+
+func TestSuggestGasPrice(t *testing.T) {
+	c, _ := newOfflineClient(t)
+	baseFee := func(amount int64) *sdk.Int {
+		i := sdk.NewInt(amount)
+		return &i
+	}
+	multiplier := sdk.NewDecWithPrec(12, 1)
+
+	testCases := []struct {
+		name    string
+		cfg     *FeeConfig
+		baseFee *sdk.Int
+		price   sdk.Dec
+	}{
+		{"default config", nil, baseFee(100), sdk.NewDec(120)},
+		{"base fee over the min", &FeeConfig{Denom: "atele", Multiplier: multiplier, MinGasPrice: sdk.NewDec(50)}, baseFee(100), sdk.NewDec(120)},
+		{"min over the base fee", &FeeConfig{Denom: "atele", Multiplier: multiplier, MinGasPrice: sdk.NewDec(200)}, baseFee(100), sdk.NewDec(240)},
+		{"disabled base fee", &FeeConfig{Denom: "atele", Multiplier: multiplier, MinGasPrice: sdk.NewDec(50)}, nil, sdk.NewDec(60)},
+		{"default fields", &FeeConfig{MaxGasPrice: sdk.NewDec(1000)}, baseFee(100), sdk.NewDec(120)},
+		{"no scaling", &FeeConfig{Denom: "atele", Multiplier: sdk.OneDec()}, baseFee(100), sdk.NewDec(100)},
+		{"capped", &FeeConfig{Denom: "atele", Multiplier: multiplier, MaxGasPrice: sdk.NewDec(110)}, baseFee(100), sdk.NewDec(110)},
+		{"under the cap", &FeeConfig{Denom: "atele", Multiplier: multiplier, MaxGasPrice: sdk.NewDec(130)}, baseFee(100), sdk.NewDec(120)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c.DisableAutoFee()
+			if tc.cfg != nil {
+				require.NoError(t, c.WithAutoFee(*tc.cfg))
+			}
+			c.FeeMarketQuery = fakeFeeMarketQuery{baseFee: tc.baseFee}
+
+			gasPrice, err := c.SuggestGasPrice()
+			require.NoError(t, err)
+			require.Equal(t, "atele", gasPrice.Denom)
+			require.True(t, tc.price.Equal(gasPrice.Amount), "expected %s, got %s", tc.price, gasPrice.Amount)
+		})
+	}
+
+	c.FeeMarketQuery = fakeFeeMarketQuery{err: status.Error(codes.Unavailable, "connection refused")}
+	_, err := c.SuggestGasPrice()
+	require.ErrorIs(t, err, types.ErrUnavailable)
+}
+
+func TestFees(t *testing.T) {
+	gasPrice := sdk.NewDecCoinFromDec("atele", sdk.MustNewDecFromStr("1.5"))
+	require.Equal(t, sdk.NewInt64Coin("atele", 300000), fees(gasPrice, 200000))
+
+	// fees are rounded up
+	gasPrice = sdk.NewDecCoinFromDec("atele", sdk.MustNewDecFromStr("0.3333"))
+	require.Equal(t, sdk.NewInt64Coin("atele", 4), fees(gasPrice, 10))
+
+	require.True(t, fees(sdk.NewDecCoin("atele", sdk.ZeroInt()), 200000).IsZero())
+}
+
+func TestWithAutoFee(t *testing.T) {
+	c, _ := newOfflineClient(t)
+	require.Error(t, c.WithAutoFee(FeeConfig{Denom: "1atele"}))
+	require.Error(t, c.WithAutoFee(FeeConfig{Multiplier: sdk.NewDec(-1)}))
+	require.Error(t, c.WithAutoFee(FeeConfig{MinGasPrice: sdk.NewDec(-1)}))
+	require.Error(t, c.WithAutoFee(FeeConfig{MaxGasPrice: sdk.NewDec(-1)}))
+	require.Nil(t, c.feeConfig)
+
+	require.NoError(t, c.WithAutoFee(FeeConfig{Multiplier: sdk.NewDec(2)}))
+	require.Equal(t, "atele", c.feeConfig.Denom)
+	require.True(t, c.feeConfig.MinGasPrice.IsZero())
+	require.True(t, c.feeConfig.MaxGasPrice.IsNil())
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/teleport-network/teleport-sdk-go/address"
+	"github.com/teleport-network/teleport-sdk-go/grpc"
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// feeGranterTxConfig sets the fee granter of every tx it builds, since sdktx.Factory has no fee granter.
+type feeGranterTxConfig struct {
+	sdkclient.TxConfig
+	feeGranter sdk.AccAddress
+}
+
+func (c feeGranterTxConfig) NewTxBuilder() sdkclient.TxBuilder {
+	txBuilder := c.TxConfig.NewTxBuilder()
+	txBuilder.SetFeeGranter(c.feeGranter)
+	return txBuilder
+}
+
+// FeeGranter returns an Option making granter pay the fees of the tx, out of an allowance it granted to the fee payer.
+func (client *TeleportClient) FeeGranter(granter sdk.AccAddress) Option {
+	return func(txf sdktx.Factory) sdktx.Factory {
+		return txf.WithTxConfig(feeGranterTxConfig{TxConfig: client.ctx.TxConfig, feeGranter: granter})
+	}
+}
+
+// GrantAllowance broadcasts msg, signed by the granter.
+func (client *TeleportClient) GrantAllowance(msg feegrant.MsgGrantAllowance, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.GrantAllowanceCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) GrantAllowanceCtx(ctx context.Context, msg feegrant.MsgGrantAllowance, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.Granter, &msg.Grantee); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// GrantBasicAllowance grants grantee to spend up to spendLimit of granter on fees until expiration.
+// A nil spendLimit is unlimited, and a nil expiration never expires.
+func (client *TeleportClient) GrantBasicAllowance(granter, grantee string, spendLimit sdk.Coins, expiration *time.Time, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.GrantBasicAllowanceCtx(context.Background(), granter, grantee, spendLimit, expiration, options...)
+}
+
+func (client *TeleportClient) GrantBasicAllowanceCtx(ctx context.Context, granter, grantee string, spendLimit sdk.Coins, expiration *time.Time, options ...Option) (*tx.BroadcastTxResponse, error) {
+	allowance := &feegrant.BasicAllowance{SpendLimit: spendLimit, Expiration: expiration}
+	return client.grantAllowanceCtx(ctx, granter, grantee, allowance, options...)
+}
+
+// GrantPeriodicAllowance grants grantee to spend up to periodSpendLimit of granter on fees in each period,
+// within the bounds of basic.
+func (client *TeleportClient) GrantPeriodicAllowance(granter, grantee string, basic feegrant.BasicAllowance, period time.Duration, periodSpendLimit sdk.Coins, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.GrantPeriodicAllowanceCtx(context.Background(), granter, grantee, basic, period, periodSpendLimit, options...)
+}
+
+func (client *TeleportClient) GrantPeriodicAllowanceCtx(ctx context.Context, granter, grantee string, basic feegrant.BasicAllowance, period time.Duration, periodSpendLimit sdk.Coins, options ...Option) (*tx.BroadcastTxResponse, error) {
+	allowance := &feegrant.PeriodicAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      time.Now().Add(period),
+	}
+	return client.grantAllowanceCtx(ctx, granter, grantee, allowance, options...)
+}
+
+// GrantAllowedMsgAllowance grants grantee the allowance for the fees of txs made only of msgs whose type url is
+// in allowedMsgs, e.g. sdk.MsgTypeURL(&banktypes.MsgSend{}).
+func (client *TeleportClient) GrantAllowedMsgAllowance(granter, grantee string, allowance feegrant.FeeAllowanceI, allowedMsgs []string, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.GrantAllowedMsgAllowanceCtx(context.Background(), granter, grantee, allowance, allowedMsgs, options...)
+}
+
+func (client *TeleportClient) GrantAllowedMsgAllowanceCtx(ctx context.Context, granter, grantee string, allowance feegrant.FeeAllowanceI, allowedMsgs []string, options ...Option) (*tx.BroadcastTxResponse, error) {
+	allowedMsgAllowance, err := feegrant.NewAllowedMsgAllowance(allowance, allowedMsgs)
+	if err != nil {
+		return nil, err
+	}
+	return client.grantAllowanceCtx(ctx, granter, grantee, allowedMsgAllowance, options...)
+}
+
+func (client *TeleportClient) grantAllowanceCtx(ctx context.Context, granter, grantee string, allowance feegrant.FeeAllowanceI, options ...Option) (*tx.BroadcastTxResponse, error) {
+	granterAddr, err := address.AccAddress(granter)
+	if err != nil {
+		return nil, err
+	}
+	granteeAddr, err := address.AccAddress(grantee)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granterAddr, granteeAddr)
+	if err != nil {
+		return nil, err
+	}
+	return client.GrantAllowanceCtx(ctx, *msg, options...)
+}
+
+// RevokeAllowance broadcasts msg, signed by the granter.
+func (client *TeleportClient) RevokeAllowance(msg feegrant.MsgRevokeAllowance, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.RevokeAllowanceCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) RevokeAllowanceCtx(ctx context.Context, msg feegrant.MsgRevokeAllowance, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.Granter, &msg.Grantee); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// Allowance queries the fee allowance granted by granter to grantee.
+func (client *TeleportClient) Allowance(granter, grantee string) (feegrant.FeeAllowanceI, error) {
+	return client.AllowanceCtx(context.Background(), granter, grantee)
+}
+
+func (client *TeleportClient) AllowanceCtx(ctx context.Context, granter, grantee string) (feegrant.FeeAllowanceI, error) {
+	if err := toBech32(&granter, &grantee); err != nil {
+		return nil, err
+	}
+	res, err := client.FeeGrantQuery.Allowance(ctx, &feegrant.QueryAllowanceRequest{Granter: granter, Grantee: grantee})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	if err := res.Allowance.UnpackInterfaces(client.ctx.InterfaceRegistry); err != nil {
+		return nil, err
+	}
+	return res.Allowance.GetGrant()
+}
+
+// Allowances queries the fee allowances granted to grantee, through all pages.
+func (client *TeleportClient) Allowances(grantee string) ([]*feegrant.Grant, error) {
+	return client.AllowancesCtx(context.Background(), grantee)
+}
+
+func (client *TeleportClient) AllowancesCtx(ctx context.Context, grantee string) ([]*feegrant.Grant, error) {
+	if err := toBech32(&grantee); err != nil {
+		return nil, err
+	}
+	var grants []*feegrant.Grant
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.FeeGrantQuery.Allowances(ctx, &feegrant.QueryAllowancesRequest{Grantee: grantee, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		grants = append(grants, res.Allowances...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// unpack the allowances for Grant.GetGrant
+	for _, grant := range grants {
+		if err := grant.UnpackInterfaces(client.ctx.InterfaceRegistry); err != nil {
+			return nil, err
+		}
+	}
+	return grants, nil
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"testing"
+
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeGranter(t *testing.T) {
+	c, from := newOfflineClient(t)
+	granter := sdk.AccAddress("granter_____________")
+	msg := banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin("atele", 1)))
+
+	txf, err := Prepare(c, from, msg, c.FeeGranter(granter), func(txf sdktx.Factory) sdktx.Factory {
+		return txf.WithGas(200000).WithFees("100atele").WithAccountNumber(1).WithSequence(1)
+	})
+	require.NoError(t, err)
+	txBytes, err := c.SignTx(txf, msg)
+	require.NoError(t, err)
+
+	decoded, err := c.ctx.TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	feeTx := decoded.(sdk.FeeTx)
+	require.Equal(t, granter, feeTx.FeeGranter())
+	require.Equal(t, from, feeTx.FeePayer())
+}
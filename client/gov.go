@@ -1,38 +1,208 @@
 package client
 
 import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/tx"
-	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/teleport-network/teleport-sdk-go/grpc"
+	"github.com/teleport-network/teleport-sdk-go/types"
 )
 
-func (client *TeleportClient) SubmitProposal(msg types.MsgSubmitProposal, options ...Option) (*tx.BroadcastTxResponse, error) {
+func (client *TeleportClient) SubmitProposal(msg govtypes.MsgSubmitProposal, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.SubmitProposalCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) SubmitProposalCtx(ctx context.Context, msg govtypes.MsgSubmitProposal, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.Proposer); err != nil {
+		return nil, err
+	}
 	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
 	if err != nil {
 		return nil, err
 	}
-	return client.Broadcast(txf, &msg)
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+func (client *TeleportClient) Deposit(msg govtypes.MsgDeposit, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.DepositCtx(context.Background(), msg, options...)
 }
 
-func (client *TeleportClient) Deposit(msg types.MsgDeposit, options ...Option) (*tx.BroadcastTxResponse, error) {
+func (client *TeleportClient) DepositCtx(ctx context.Context, msg govtypes.MsgDeposit, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.Depositor); err != nil {
+		return nil, err
+	}
 	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
 	if err != nil {
 		return nil, err
 	}
-	return client.Broadcast(txf, &msg)
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+func (client *TeleportClient) Vote(msg govtypes.MsgVote, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.VoteCtx(context.Background(), msg, options...)
 }
 
-func (client *TeleportClient) Vote(msg types.MsgVote, options ...Option) (*tx.BroadcastTxResponse, error) {
+func (client *TeleportClient) VoteCtx(ctx context.Context, msg govtypes.MsgVote, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.Voter); err != nil {
+		return nil, err
+	}
 	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
 	if err != nil {
 		return nil, err
 	}
-	return client.Broadcast(txf, &msg)
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+func (client *TeleportClient) VoteWeighted(msg govtypes.MsgVoteWeighted, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.VoteWeightedCtx(context.Background(), msg, options...)
 }
 
-func (client *TeleportClient) VoteWeighted(msg types.MsgVoteWeighted, options ...Option) (*tx.BroadcastTxResponse, error) {
+func (client *TeleportClient) VoteWeightedCtx(ctx context.Context, msg govtypes.MsgVoteWeighted, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.Voter); err != nil {
+		return nil, err
+	}
 	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
 	if err != nil {
 		return nil, err
 	}
-	return client.Broadcast(txf, &msg)
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// Proposals queries the proposals with the given status, voted by voter and deposited by depositor, through
+// all pages. The zero value of each filter matches all proposals. The content of each proposal is decoded,
+// so that Proposal.GetContent returns the concrete type of the known proposal kinds.
+func (client *TeleportClient) Proposals(status govtypes.ProposalStatus, voter, depositor string) (govtypes.Proposals, error) {
+	return client.ProposalsCtx(context.Background(), status, voter, depositor)
+}
+
+func (client *TeleportClient) ProposalsCtx(ctx context.Context, status govtypes.ProposalStatus, voter, depositor string) (govtypes.Proposals, error) {
+	if voter != "" {
+		if err := toBech32(&voter); err != nil {
+			return nil, err
+		}
+	}
+	if depositor != "" {
+		if err := toBech32(&depositor); err != nil {
+			return nil, err
+		}
+	}
+	var proposals govtypes.Proposals
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.GovQuery.Proposals(ctx, &govtypes.QueryProposalsRequest{
+			ProposalStatus: status,
+			Voter:          voter,
+			Depositor:      depositor,
+			Pagination:     pageReq,
+		})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		proposals = append(proposals, res.Proposals...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := proposals.UnpackInterfaces(client.ctx.InterfaceRegistry); err != nil {
+		return nil, err
+	}
+	return proposals, nil
+}
+
+// Proposal queries the proposal of the given id, with its content decoded.
+func (client *TeleportClient) Proposal(proposalId uint64) (govtypes.Proposal, error) {
+	return client.ProposalCtx(context.Background(), proposalId)
+}
+
+func (client *TeleportClient) ProposalCtx(ctx context.Context, proposalId uint64) (govtypes.Proposal, error) {
+	res, err := client.GovQuery.Proposal(ctx, &govtypes.QueryProposalRequest{ProposalId: proposalId})
+	if err != nil {
+		return govtypes.Proposal{}, types.WrapNodeError(err)
+	}
+	if err := res.Proposal.UnpackInterfaces(client.ctx.InterfaceRegistry); err != nil {
+		return govtypes.Proposal{}, err
+	}
+	return res.Proposal, nil
+}
+
+// Votes queries the votes on the proposal of the given id, through all pages.
+func (client *TeleportClient) Votes(proposalId uint64) (govtypes.Votes, error) {
+	return client.VotesCtx(context.Background(), proposalId)
+}
+
+func (client *TeleportClient) VotesCtx(ctx context.Context, proposalId uint64) (govtypes.Votes, error) {
+	var votes govtypes.Votes
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.GovQuery.Votes(ctx, &govtypes.QueryVotesRequest{ProposalId: proposalId, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		votes = append(votes, res.Votes...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return votes, nil
+}
+
+// Tally queries the tally of the proposal of the given id, final once its voting period ended.
+func (client *TeleportClient) Tally(proposalId uint64) (govtypes.TallyResult, error) {
+	return client.TallyCtx(context.Background(), proposalId)
+}
+
+func (client *TeleportClient) TallyCtx(ctx context.Context, proposalId uint64) (govtypes.TallyResult, error) {
+	res, err := client.GovQuery.TallyResult(ctx, &govtypes.QueryTallyResultRequest{ProposalId: proposalId})
+	if err != nil {
+		return govtypes.TallyResult{}, types.WrapNodeError(err)
+	}
+	return res.Tally, nil
+}
+
+// Deposits queries the deposits on the proposal of the given id, through all pages.
+func (client *TeleportClient) Deposits(proposalId uint64) (govtypes.Deposits, error) {
+	return client.DepositsCtx(context.Background(), proposalId)
+}
+
+func (client *TeleportClient) DepositsCtx(ctx context.Context, proposalId uint64) (govtypes.Deposits, error) {
+	var deposits govtypes.Deposits
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.GovQuery.Deposits(ctx, &govtypes.QueryDepositsRequest{ProposalId: proposalId, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		deposits = append(deposits, res.Deposits...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
+
+// GovParams queries the voting, tallying and deposit params of the gov module.
+func (client *TeleportClient) GovParams() (govtypes.Params, error) {
+	return client.GovParamsCtx(context.Background())
+}
+
+func (client *TeleportClient) GovParamsCtx(ctx context.Context) (govtypes.Params, error) {
+	var params govtypes.Params
+	for _, paramsType := range []string{govtypes.ParamVoting, govtypes.ParamTallying, govtypes.ParamDeposit} {
+		res, err := client.GovQuery.Params(ctx, &govtypes.QueryParamsRequest{ParamsType: paramsType})
+		if err != nil {
+			return govtypes.Params{}, types.WrapNodeError(err)
+		}
+		switch paramsType {
+		case govtypes.ParamVoting:
+			params.VotingParams = res.VotingParams
+		case govtypes.ParamTallying:
+			params.TallyParams = res.TallyParams
+		case govtypes.ParamDeposit:
+			params.DepositParams = res.DepositParams
+		}
+	}
+	return params, nil
 }
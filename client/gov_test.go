@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeGovQuery serves proposals, votes and deposits in pages, as a node does with the content of the
+// proposals packed but not decoded, and records the voter filter and the number of pages queried.
+type fakeGovQuery struct {
+	govtypes.QueryClient
+
+	proposals govtypes.Proposals
+	votes     govtypes.Votes
+	deposits  govtypes.Deposits
+	voter     string
+	pages     int
+}
+
+func (q *fakeGovQuery) Proposals(_ context.Context, req *govtypes.QueryProposalsRequest, _ ...grpc.CallOption) (*govtypes.QueryProposalsResponse, error) {
+	q.voter = req.Voter
+	q.pages++
+	start, end, pageRes := page(req.Pagination, len(q.proposals))
+	return &govtypes.QueryProposalsResponse{Proposals: q.proposals[start:end], Pagination: pageRes}, nil
+}
+
+func (q *fakeGovQuery) Proposal(_ context.Context, req *govtypes.QueryProposalRequest, _ ...grpc.CallOption) (*govtypes.QueryProposalResponse, error) {
+	return &govtypes.QueryProposalResponse{Proposal: q.proposals[req.ProposalId-1]}, nil
+}
+
+func (q *fakeGovQuery) Votes(_ context.Context, req *govtypes.QueryVotesRequest, _ ...grpc.CallOption) (*govtypes.QueryVotesResponse, error) {
+	q.pages++
+	start, end, pageRes := page(req.Pagination, len(q.votes))
+	return &govtypes.QueryVotesResponse{Votes: q.votes[start:end], Pagination: pageRes}, nil
+}
+
+func (q *fakeGovQuery) Deposits(_ context.Context, req *govtypes.QueryDepositsRequest, _ ...grpc.CallOption) (*govtypes.QueryDepositsResponse, error) {
+	q.pages++
+	start, end, pageRes := page(req.Pagination, len(q.deposits))
+	return &govtypes.QueryDepositsResponse{Deposits: q.deposits[start:end], Pagination: pageRes}, nil
+}
+
+// packedProposal returns a proposal with a text content packed as the node sends it, without the cached value.
+func packedProposal(t *testing.T, id uint64, title string) govtypes.Proposal {
+	bz, err := proto.Marshal(&govtypes.TextProposal{Title: title, Description: "description"})
+	require.NoError(t, err)
+	return govtypes.Proposal{
+		ProposalId: id,
+		Content:    &codectypes.Any{TypeUrl: "/cosmos.gov.v1beta1.TextProposal", Value: bz},
+	}
+}
+
+func TestGovQueries(t *testing.T) {
+	c, from := newOfflineClient(t)
+	govQuery := &fakeGovQuery{}
+	for i, title := range []string{"one", "two", "three"} {
+		id := uint64(i + 1)
+		govQuery.proposals = append(govQuery.proposals, packedProposal(t, id, title))
+		govQuery.votes = append(govQuery.votes, govtypes.NewVote(id, from, govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+		govQuery.deposits = append(govQuery.deposits, govtypes.NewDeposit(id, from, sdk.NewCoins(sdk.NewInt64Coin("atele", 1))))
+	}
+	c.GovQuery = govQuery
+
+	proposals, err := c.Proposals(govtypes.StatusNil, common.BytesToAddress(from).Hex(), "")
+	require.NoError(t, err)
+	require.Equal(t, from.String(), govQuery.voter)
+	require.Equal(t, 2, govQuery.pages)
+	require.Len(t, proposals, 3)
+	for i, title := range []string{"one", "two", "three"} {
+		content, ok := proposals[i].GetContent().(*govtypes.TextProposal)
+		require.True(t, ok)
+		require.Equal(t, title, content.Title)
+	}
+
+	proposal, err := c.Proposal(2)
+	require.NoError(t, err)
+	content, ok := proposal.GetContent().(*govtypes.TextProposal)
+	require.True(t, ok)
+	require.Equal(t, "two", content.Title)
+
+	govQuery.pages = 0
+	votes, err := c.Votes(1)
+	require.NoError(t, err)
+	require.Equal(t, govQuery.votes, votes)
+	require.Equal(t, 2, govQuery.pages)
+
+	govQuery.pages = 0
+	deposits, err := c.Deposits(1)
+	require.NoError(t, err)
+	require.Equal(t, govQuery.deposits, deposits)
+	require.Equal(t, 2, govQuery.pages)
+
+	_, err = c.Proposals(govtypes.StatusNil, "0xnot-an-address", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,253 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// A multisig tx is built in three steps:
+//
+//  1. GenerateMultisigTx builds the unsigned tx, encoded in JSON, which is handed to the participants.
+//  2. Each participant signs it with SignMultisigTx using a key of its own keyring.
+//  3. CombineMultisigSignatures merges the partial signatures into the signed tx bytes, which are
+//     broadcast with BroadcastSigned.
+//
+// The unsigned tx carries an empty signature of the multisig account holding its public key, its
+// sequence and, in SIGN_MODE_DIRECT, the participants expected to sign, since their set is part of
+// the signed bytes. In SIGN_MODE_LEGACY_AMINO_JSON, which is the default, any participants may sign.
+
+// SaveMultisigKey stores the public key of a multisig account in the keyring and returns its address.
+func (client *TeleportClient) SaveMultisigKey(name string, threshold int, pubKeys []cryptotypes.PubKey) (string, error) {
+	if client.ctx.Keyring == nil {
+		return "", errors.New("no keyring found, please add keyring first")
+	}
+	info, err := client.ctx.Keyring.SaveMultisig(name, kmultisig.NewLegacyAminoPubKey(threshold, pubKeys))
+	if err != nil {
+		return "", err
+	}
+	return info.GetAddress().String(), nil
+}
+
+// GenerateMultisigTx builds the unsigned tx of msgs sent by the multisig account of multisigKey.
+// The gas must be set on txf. Unless txf has an account number or a sequence, the sequence is
+// queried from the node. In SIGN_MODE_DIRECT, signers lists the participants that will sign,
+// at least as many as the threshold; it is ignored in SIGN_MODE_LEGACY_AMINO_JSON.
+func (client *TeleportClient) GenerateMultisigTx(txf sdktx.Factory, multisigKey cryptotypes.PubKey, signers []cryptotypes.PubKey, msgs ...sdk.Msg) ([]byte, error) {
+	return client.GenerateMultisigTxCtx(context.Background(), txf, multisigKey, signers, msgs...)
+}
+
+func (client *TeleportClient) GenerateMultisigTxCtx(ctx context.Context, txf sdktx.Factory, multisigKey cryptotypes.PubKey, signers []cryptotypes.PubKey, msgs ...sdk.Msg) ([]byte, error) {
+	multisigPubKey, ok := multisigKey.(multisigtypes.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a multisig public key", multisigKey)
+	}
+	if len(msgs) == 0 {
+		return nil, errors.New("no message to send")
+	}
+	multisigAddr := sdk.AccAddress(multisigKey.Address())
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(multisigAddr) {
+				return nil, fmt.Errorf("message signer %s is not the multisig account %s", signer, multisigAddr)
+			}
+		}
+	}
+	if txf.Gas() == 0 {
+		return nil, errors.New("gas must be set to generate a multisig tx")
+	}
+
+	if txf.AccountNumber() == 0 && txf.Sequence() == 0 {
+		acc, err := client.GetAccountCtx(ctx, multisigAddr.String())
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithSequence(acc.GetSequence())
+	}
+
+	sigData := multisigtypes.NewMultisig(len(multisigPubKey.GetPubKeys()))
+	switch txf.SignMode() {
+	case signing.SignMode_SIGN_MODE_UNSPECIFIED, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+	case signing.SignMode_SIGN_MODE_DIRECT:
+		if len(signers) < int(multisigPubKey.GetThreshold()) {
+			return nil, fmt.Errorf("%d signers expected at least, got %d", multisigPubKey.GetThreshold(), len(signers))
+		}
+		// the signatures are left empty, only their sign modes are part of the signed bytes
+		for _, signer := range signers {
+			empty := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT}
+			if err := multisigtypes.AddSignatureFromPubKey(sigData, empty, signer, multisigPubKey.GetPubKeys()); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported sign mode %s for a multisig tx", txf.SignMode())
+	}
+
+	txBuilder, err := sdktx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multisigKey,
+		Data:     sigData,
+		Sequence: txf.Sequence(),
+	}); err != nil {
+		return nil, err
+	}
+	return client.ctx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+}
+
+// SignMultisigTx signs the unsigned multisig tx generated by GenerateMultisigTx with the key
+// named name, and returns the partial signature encoded in JSON. The chain id and the account
+// number of the multisig account are taken from txf; the account number is queried from the
+// node if it is not set.
+func (client *TeleportClient) SignMultisigTx(txf sdktx.Factory, name string, txJSON []byte) ([]byte, error) {
+	return client.SignMultisigTxCtx(context.Background(), txf, name, txJSON)
+}
+
+func (client *TeleportClient) SignMultisigTxCtx(ctx context.Context, txf sdktx.Factory, name string, txJSON []byte) ([]byte, error) {
+	if client.ctx.Keyring == nil {
+		return nil, errors.New("keyring must be imported")
+	}
+	txBuilder, placeholder, multisigPubKey, err := client.decodeMultisigTx(txJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := client.ctx.Keyring.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	index := -1
+	for i, pubKey := range multisigPubKey.GetPubKeys() {
+		if pubKey.Equals(info.GetPubKey()) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("key %s is not a participant of the multisig account", name)
+	}
+
+	signMode := signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	expected := placeholder.Data.(*signing.MultiSignatureData)
+	if len(expected.Signatures) > 0 {
+		signMode = signing.SignMode_SIGN_MODE_DIRECT
+		if !expected.BitArray.GetIndex(index) {
+			return nil, fmt.Errorf("key %s is not an expected signer of the tx", name)
+		}
+	}
+
+	accountNumber := txf.AccountNumber()
+	if accountNumber == 0 {
+		acc, err := client.GetAccountCtx(ctx, sdk.AccAddress(placeholder.PubKey.Address()).String())
+		if err != nil {
+			return nil, err
+		}
+		accountNumber = acc.GetAccountNumber()
+	}
+	chainID := txf.ChainID()
+	if chainID == "" {
+		chainID = client.ctx.ChainID
+	}
+
+	signBytes, err := client.ctx.TxConfig.SignModeHandler().GetSignBytes(
+		signMode,
+		authsigning.SignerData{ChainID: chainID, AccountNumber: accountNumber, Sequence: placeholder.Sequence},
+		txBuilder.GetTx(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	sig, pubKey, err := client.ctx.Keyring.Sign(name, signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ctx.TxConfig.MarshalSignatureJSON([]signing.SignatureV2{{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signMode, Signature: sig},
+		Sequence: placeholder.Sequence,
+	}})
+}
+
+// CombineMultisigSignatures merges the partial signatures produced by SignMultisigTx into the
+// unsigned multisig tx, and returns the encoded signed tx.
+func (client *TeleportClient) CombineMultisigSignatures(txJSON []byte, signaturesJSON ...[]byte) ([]byte, error) {
+	txBuilder, placeholder, multisigPubKey, err := client.decodeMultisigTx(txJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	sigData := multisigtypes.NewMultisig(len(multisigPubKey.GetPubKeys()))
+	for _, sigJSON := range signaturesJSON {
+		sigs, err := client.ctx.TxConfig.UnmarshalSignatureJSON(sigJSON)
+		if err != nil {
+			return nil, err
+		}
+		for _, sig := range sigs {
+			if sig.Sequence != placeholder.Sequence {
+				return nil, fmt.Errorf("signature of sequence %d, expected %d", sig.Sequence, placeholder.Sequence)
+			}
+			if err := multisigtypes.AddSignatureV2(sigData, sig, multisigPubKey.GetPubKeys()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(sigData.Signatures) < int(multisigPubKey.GetThreshold()) {
+		return nil, fmt.Errorf("%d signatures expected at least, got %d", multisigPubKey.GetThreshold(), len(sigData.Signatures))
+	}
+	// in SIGN_MODE_DIRECT the signers are part of the signed bytes, so they must be the expected ones
+	expected := placeholder.Data.(*signing.MultiSignatureData)
+	if len(expected.Signatures) > 0 && expected.BitArray.String() != sigData.BitArray.String() {
+		return nil, errors.New("the signers do not match the expected signers of the tx")
+	}
+
+	if err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   placeholder.PubKey,
+		Data:     sigData,
+		Sequence: placeholder.Sequence,
+	}); err != nil {
+		return nil, err
+	}
+	return client.ctx.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// decodeMultisigTx decodes an unsigned multisig tx and returns its empty multisig signature.
+func (client *TeleportClient) decodeMultisigTx(txJSON []byte) (sdkclient.TxBuilder, signing.SignatureV2, multisigtypes.PubKey, error) {
+	sdkTx, err := client.ctx.TxConfig.TxJSONDecoder()(txJSON)
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+	txBuilder, err := client.ctx.TxConfig.WrapTxBuilder(sdkTx)
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+	if len(sigs) != 1 {
+		return nil, signing.SignatureV2{}, nil, errors.New("not a multisig tx")
+	}
+	multisigPubKey, ok := sigs[0].PubKey.(multisigtypes.PubKey)
+	if !ok {
+		return nil, signing.SignatureV2{}, nil, errors.New("not a multisig tx")
+	}
+	if _, ok := sigs[0].Data.(*signing.MultiSignatureData); !ok {
+		return nil, signing.SignatureV2{}, nil, errors.New("not a multisig tx")
+	}
+	return txBuilder, sigs[0], multisigPubKey, nil
+}
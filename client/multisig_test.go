@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tharsis/ethermint/crypto/hd"
+)
+
+func TestMultisigTx(t *testing.T) {
+	for _, signMode := range []signing.SignMode{signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signing.SignMode_SIGN_MODE_DIRECT} {
+		t.Run(signMode.String(), func(t *testing.T) {
+			c, _ := newOfflineClient(t)
+
+			var pubKeys []cryptotypes.PubKey
+			for i := 0; i < 3; i++ {
+				info, _, err := c.ctx.Keyring.NewMnemonic(fmt.Sprintf("participant%d", i), keyring.English, sdk.GetConfig().GetFullBIP44Path(), "", hd.EthSecp256k1)
+				require.NoError(t, err)
+				pubKeys = append(pubKeys, info.GetPubKey())
+			}
+			addr, err := c.SaveMultisigKey("multisig", 2, pubKeys)
+			require.NoError(t, err)
+			info, err := c.ctx.Keyring.Key("multisig")
+			require.NoError(t, err)
+			from, err := sdk.AccAddressFromBech32(addr)
+			require.NoError(t, err)
+
+			msg := banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewCoin("atele", sdk.NewInt(1))))
+			txf := c.NewFactory(func(txf sdktx.Factory) sdktx.Factory {
+				return txf.WithGas(200000).WithFees("100atele").WithAccountNumber(9).WithSequence(3).WithSignMode(signMode)
+			})
+
+			unsignedTx, err := c.GenerateMultisigTx(txf, info.GetPubKey(), pubKeys[1:], msg)
+			require.NoError(t, err)
+
+			sig1, err := c.SignMultisigTx(txf, "participant1", unsignedTx)
+			require.NoError(t, err)
+			sig2, err := c.SignMultisigTx(txf, "participant2", unsignedTx)
+			require.NoError(t, err)
+
+			_, err = c.CombineMultisigSignatures(unsignedTx, sig1)
+			require.Error(t, err)
+
+			txBytes, err := c.CombineMultisigSignatures(unsignedTx, sig2, sig1)
+			require.NoError(t, err)
+
+			decoded, err := c.ctx.TxConfig.TxDecoder()(txBytes)
+			require.NoError(t, err)
+			sigs, err := decoded.(authsigning.SigVerifiableTx).GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+			require.NoError(t, authsigning.VerifySignature(
+				sigs[0].PubKey,
+				authsigning.SignerData{ChainID: c.ctx.ChainID, AccountNumber: 9, Sequence: 3},
+				sigs[0].Data,
+				c.ctx.TxConfig.SignModeHandler(),
+				decoded,
+			))
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// SignTx builds and signs a tx without any node access, and returns its encoded bytes.
+// The gas, account number and sequence are taken from txf, so they have to be set by options:
+//
+//	txf, err := Prepare(client, signer, msg, func(txf sdktx.Factory) sdktx.Factory {
+//		return txf.WithGas(200000).WithFees("4000000000000000atele").WithAccountNumber(7).WithSequence(12)
+//	})
+//	txBytes, err := client.SignTx(txf, msg)
+func (client *TeleportClient) SignTx(txf sdktx.Factory, msgs ...sdk.Msg) ([]byte, error) {
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	if txf.Gas() == 0 {
+		return nil, errors.New("gas must be set to sign offline")
+	}
+	signers, err := client.txSigners(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) != 1 {
+		return nil, errors.New("offline signing supports a single signer only")
+	}
+	signers[0].accountNumber, signers[0].sequence = txf.AccountNumber(), txf.Sequence()
+
+	txBuilder, err := sdktx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := signTx(txf, txBuilder, signers); err != nil {
+		return nil, err
+	}
+	return client.ctx.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// SignTxJSON is like SignTx but returns the signed tx encoded in JSON.
+func (client *TeleportClient) SignTxJSON(txf sdktx.Factory, msgs ...sdk.Msg) ([]byte, error) {
+	txBytes, err := client.SignTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	return client.TxBytesToJSON(txBytes)
+}
+
+// TxBytesToJSON converts an encoded tx to JSON.
+func (client *TeleportClient) TxBytesToJSON(txBytes []byte) ([]byte, error) {
+	sdkTx, err := client.ctx.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	return client.ctx.TxConfig.TxJSONEncoder()(sdkTx)
+}
+
+// TxJSONToBytes converts a JSON tx to its encoded bytes.
+func (client *TeleportClient) TxJSONToBytes(txJSON []byte) ([]byte, error) {
+	sdkTx, err := client.ctx.TxConfig.TxJSONDecoder()(txJSON)
+	if err != nil {
+		return nil, err
+	}
+	return client.ctx.TxConfig.TxEncoder()(sdkTx)
+}
+
+// BroadcastSigned broadcasts the bytes of a tx signed elsewhere, e.g. by SignTx.
+// Unlike BroadcastTx, a tx rejected by the node is returned together with a *types.TxError.
+// The sequences tracked by the client are not updated, so it should not send from the same
+// accounts concurrently.
+func (client *TeleportClient) BroadcastSigned(txBytes []byte) (*tx.BroadcastTxResponse, error) {
+	return client.BroadcastSignedCtx(context.Background(), txBytes)
+}
+
+func (client *TeleportClient) BroadcastSignedCtx(ctx context.Context, txBytes []byte) (*tx.BroadcastTxResponse, error) {
+	res, err := client.BroadcastTxCtx(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.TxResponse.Code != 0 {
+		return res, types.NewTxError(res.TxResponse)
+	}
+	return res, nil
+}
+
+// BroadcastSignedAndWait broadcasts the bytes of a tx signed elsewhere in sync mode, then waits
+// until it is committed, see BroadcastAndWait.
+func (client *TeleportClient) BroadcastSignedAndWait(txBytes []byte) (*TxResult, error) {
+	return client.BroadcastSignedAndWaitCtx(context.Background(), txBytes)
+}
+
+func (client *TeleportClient) BroadcastSignedAndWaitCtx(ctx context.Context, txBytes []byte) (*TxResult, error) {
+	res, err := client.broadcastTx(ctx, flags.BroadcastSync, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.TxResponse.Code != 0 {
+		return nil, types.NewTxError(res.TxResponse)
+	}
+	return client.WaitForTxCtx(ctx, res.TxResponse.TxHash)
+}
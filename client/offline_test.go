@@ -0,0 +1,66 @@
+package client
+
+import (
+	"testing"
+
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "donate broccoli change around paper fetch rifle matrix guide pioneer catalog blur okay absorb rude much chef assist virtual turn exhaust wing output scene"
+
+func newOfflineClient(t *testing.T) (*TeleportClient, sdk.AccAddress) {
+	c, err := NewClient("localhost:9090", "teleport_9000-1")
+	require.NoError(t, err)
+	c.WithKeyring(keyring.NewInMemory(c.GetCtx().KeyringOptions...))
+	require.NoError(t, c.ImportMnemonic("acc1", testMnemonic))
+	addr, err := c.Key("acc1")
+	require.NoError(t, err)
+	from, err := sdk.AccAddressFromBech32(addr)
+	require.NoError(t, err)
+	return c, from
+}
+
+func TestSignTx(t *testing.T) {
+	c, from := newOfflineClient(t)
+	msg := banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewCoin("atele", sdk.NewInt(1))))
+
+	txf, err := Prepare(c, from, msg, func(txf sdktx.Factory) sdktx.Factory {
+		return txf.WithGas(200000).WithFees("100atele").WithAccountNumber(7).WithSequence(12)
+	})
+	require.NoError(t, err)
+
+	txBytes, err := c.SignTx(txf, msg)
+	require.NoError(t, err)
+
+	txJSON, err := c.TxBytesToJSON(txBytes)
+	require.NoError(t, err)
+	decodedBytes, err := c.TxJSONToBytes(txJSON)
+	require.NoError(t, err)
+	require.Equal(t, txBytes, decodedBytes)
+
+	decoded, err := c.ctx.TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	sigTx := decoded.(authsigning.SigVerifiableTx)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.EqualValues(t, 12, sigs[0].Sequence)
+
+	signBytes, err := c.ctx.TxConfig.SignModeHandler().GetSignBytes(
+		signing.SignMode_SIGN_MODE_DIRECT,
+		authsigning.SignerData{ChainID: "teleport_9000-1", AccountNumber: 7, Sequence: 12},
+		decoded,
+	)
+	require.NoError(t, err)
+	sigData := sigs[0].Data.(*signing.SingleSignatureData)
+	require.True(t, sigs[0].PubKey.VerifySignature(signBytes, sigData.Signature))
+
+	_, err = c.SignTx(txf.WithGas(0), msg)
+	require.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	aggregatetypes "github.com/teleport-network/teleport/x/aggregate/types"
+	clienttypes "github.com/teleport-network/teleport/x/xibc/core/client/types"
+	"github.com/teleport-network/teleport/x/xibc/exported"
+
+	"github.com/teleport-network/teleport-sdk-go/address"
+)
+
+// NewProposal returns the MsgSubmitProposal of content by proposer with initialDeposit, validated locally.
+// The builders below use it for the known proposal kinds.
+func NewProposal(content govtypes.Content, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	proposerAddr, err := address.AccAddress(proposer)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := govtypes.NewMsgSubmitProposal(content, initialDeposit, proposerAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func NewTextProposal(title, description string, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	return NewProposal(govtypes.NewTextProposal(title, description), initialDeposit, proposer)
+}
+
+func NewParameterChangeProposal(title, description string, changes []paramsproposal.ParamChange, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	return NewProposal(paramsproposal.NewParameterChangeProposal(title, description, changes), initialDeposit, proposer)
+}
+
+func NewCommunityPoolSpendProposal(title, description, recipient string, amount sdk.Coins, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	recipientAddr, err := address.AccAddress(recipient)
+	if err != nil {
+		return nil, err
+	}
+	return NewProposal(distrtypes.NewCommunityPoolSpendProposal(title, description, recipientAddr, amount), initialDeposit, proposer)
+}
+
+func NewSoftwareUpgradeProposal(title, description string, plan upgradetypes.Plan, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	return NewProposal(upgradetypes.NewSoftwareUpgradeProposal(title, description, plan), initialDeposit, proposer)
+}
+
+func NewCancelSoftwareUpgradeProposal(title, description string, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	return NewProposal(upgradetypes.NewCancelSoftwareUpgradeProposal(title, description), initialDeposit, proposer)
+}
+
+// NewCreateClientProposal returns the proposal to create the xibc client of chainName.
+func NewCreateClientProposal(title, description, chainName string, clientState exported.ClientState, consensusState exported.ConsensusState, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	content, err := clienttypes.NewCreateClientProposal(title, description, chainName, clientState, consensusState)
+	if err != nil {
+		return nil, err
+	}
+	return NewProposal(content, initialDeposit, proposer)
+}
+
+// NewUpgradeClientProposal returns the proposal to replace the states of the xibc client of chainName.
+func NewUpgradeClientProposal(title, description, chainName string, clientState exported.ClientState, consensusState exported.ConsensusState, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	content, err := clienttypes.NewUpgradeClientProposal(title, description, chainName, clientState, consensusState)
+	if err != nil {
+		return nil, err
+	}
+	return NewProposal(content, initialDeposit, proposer)
+}
+
+// NewRegisterCoinProposal returns the proposal to register the token pair of a native coin, deploying its ERC20 contract.
+func NewRegisterCoinProposal(title, description string, metadata banktypes.Metadata, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	return NewProposal(aggregatetypes.NewRegisterCoinProposal(title, description, metadata), initialDeposit, proposer)
+}
+
+// NewRegisterERC20Proposal returns the proposal to register the token pair of the ERC20 contract at erc20Address.
+func NewRegisterERC20Proposal(title, description, erc20Address string, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	return NewProposal(aggregatetypes.NewRegisterERC20Proposal(title, description, erc20Address), initialDeposit, proposer)
+}
+
+// NewAddCoinProposal returns the proposal to add a native coin to the token pair of the ERC20 contract at contractAddress.
+func NewAddCoinProposal(title, description string, metadata banktypes.Metadata, contractAddress string, initialDeposit sdk.Coins, proposer string) (*govtypes.MsgSubmitProposal, error) {
+	return NewProposal(aggregatetypes.NewAddCoinProposal(title, description, metadata, contractAddress), initialDeposit, proposer)
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	aggregatetypes "github.com/teleport-network/teleport/x/aggregate/types"
+)
+
+func TestProposalBuilders(t *testing.T) {
+	proposer := sdk.AccAddress("proposer____________").String()
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("atele", 100))
+
+	msg, err := NewTextProposal("title", "description", deposit, proposer)
+	require.NoError(t, err)
+	require.Equal(t, govtypes.ProposalTypeText, msg.GetContent().ProposalType())
+	require.Equal(t, deposit, msg.GetInitialDeposit())
+
+	msg, err = NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+		paramsproposal.NewParamChange("staking", "MaxValidators", `"100"`),
+	}, deposit, proposer)
+	require.NoError(t, err)
+	require.IsType(t, &paramsproposal.ParameterChangeProposal{}, msg.GetContent())
+
+	msg, err = NewSoftwareUpgradeProposal("title", "description", upgradetypes.Plan{Name: "v2", Height: 100}, deposit, proposer)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), msg.GetContent().(*upgradetypes.SoftwareUpgradeProposal).Plan.Height)
+
+	msg, err = NewRegisterERC20Proposal("title", "description", "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd", deposit, proposer)
+	require.NoError(t, err)
+	require.IsType(t, &aggregatetypes.RegisterERC20Proposal{}, msg.GetContent())
+
+	// invalid fields are rejected locally
+	_, err = NewTextProposal("", "description", deposit, proposer)
+	require.Error(t, err)
+	_, err = NewSoftwareUpgradeProposal("title", "description", upgradetypes.Plan{Name: "v2"}, deposit, proposer)
+	require.Error(t, err)
+	_, err = NewRegisterERC20Proposal("title", "description", "0x1", deposit, proposer)
+	require.Error(t, err)
+	_, err = NewCommunityPoolSpendProposal("title", "description", "teleport1invalid", deposit, deposit, proposer)
+	require.Error(t, err)
+	_, err = NewCancelSoftwareUpgradeProposal("title", "description", deposit, "teleport1invalid")
+	require.Error(t, err)
+}
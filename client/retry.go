@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/avast/retry-go"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// RetryPolicy defines how Broadcast and CalculateGas retry a failed attempt.
+type RetryPolicy struct {
+	// Attempts is the total number of attempts, including the first one.
+	Attempts uint
+	// Delay is the base delay of the exponential backoff.
+	Delay time.Duration
+	// MaxDelay caps the backoff delay, zero means no cap.
+	MaxDelay time.Duration
+	// MaxJitter is the maximum random duration added to each delay.
+	MaxJitter time.Duration
+	// Retryable lists the typed errors that are retried, matched with errors.Is.
+	Retryable []error
+	// OnRetry, if set, is called before each retry with the attempt number and its error.
+	OnRetry func(n uint, err error)
+}
+
+// DefaultRetryPolicy retries sequence mismatches up to 3 attempts.
+var DefaultRetryPolicy = RetryPolicy{
+	Attempts:  3,
+	Delay:     100 * time.Millisecond,
+	MaxDelay:  2 * time.Second,
+	MaxJitter: 100 * time.Millisecond,
+	Retryable: []error{types.ErrSequenceMismatch},
+}
+
+// IsRetryable reports whether the error is one of the retryable errors of the policy.
+func (p RetryPolicy) IsRetryable(err error) bool {
+	for _, target := range p.Retryable {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// do runs fn under the policy. It returns the error of ctx if ctx aborts the retries.
+func (p RetryPolicy) do(ctx context.Context, fn func() error) error {
+	attempts := p.Attempts
+	if attempts == 0 {
+		attempts = 1
+	}
+	delayType := retry.BackOffDelay
+	if p.MaxJitter > 0 {
+		// RandomDelay panics on a zero jitter
+		delayType = retry.CombineDelay(retry.BackOffDelay, retry.RandomDelay)
+	}
+	err := retry.Do(
+		fn,
+		retry.Attempts(attempts),
+		retry.Delay(p.Delay),
+		retry.MaxDelay(p.MaxDelay),
+		retry.MaxJitter(p.MaxJitter),
+		retry.DelayType(delayType),
+		retry.RetryIf(func(err error) bool {
+			return ctx.Err() == nil && p.IsRetryable(err)
+		}),
+		retry.OnRetry(func(n uint, err error) {
+			if p.OnRetry != nil {
+				p.OnRetry(n, err)
+			}
+		}),
+		retry.Context(ctx),
+		retry.LastErrorOnly(true),
+	)
+	if err != nil && ctx.Err() != nil && p.IsRetryable(err) {
+		// the retries were aborted by ctx
+		return ctx.Err()
+	}
+	return err
+}
+
+type retryPolicyKey struct{}
+
+// ContextWithRetryPolicy returns a copy of ctx which overrides the retry policy of the client
+// for the calls made with it.
+func ContextWithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// retryPolicy returns the policy set on ctx, or the one of the client if there is none.
+func (client *TeleportClient) retryPolicy(ctx context.Context) RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy); ok {
+		return policy
+	}
+	return client.retry
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	var retried []uint
+	policy := RetryPolicy{
+		Attempts:  4,
+		Delay:     time.Millisecond,
+		Retryable: []error{types.ErrUnavailable, types.ErrMempoolFull},
+		OnRetry: func(n uint, err error) {
+			retried = append(retried, n)
+		},
+	}
+
+	attempts := 0
+	err := policy.do(context.Background(), func() error {
+		attempts++
+		if attempts < 3 {
+			return types.ErrMempoolFull
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, attempts)
+	require.Equal(t, []uint{0, 1}, retried)
+
+	attempts = 0
+	err = policy.do(context.Background(), func() error {
+		attempts++
+		return types.ErrOutOfGas
+	})
+	require.True(t, errors.Is(err, types.ErrOutOfGas))
+	require.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicyCancel(t *testing.T) {
+	policy := RetryPolicy{
+		Attempts:  10,
+		Delay:     time.Hour,
+		Retryable: []error{types.ErrUnavailable},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	err := policy.do(ctx, func() error {
+		attempts++
+		cancel()
+		return types.ErrUnavailable
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, attempts)
+}
+
+func TestContextWithRetryPolicy(t *testing.T) {
+	client := &TeleportClient{retry: DefaultRetryPolicy}
+	require.EqualValues(t, 3, client.retryPolicy(context.Background()).Attempts)
+
+	ctx := ContextWithRetryPolicy(context.Background(), RetryPolicy{Attempts: 7})
+	require.EqualValues(t, 7, client.retryPolicy(ctx).Attempts)
+}
+
+func TestBroadcastRetryPolicy(t *testing.T) {
+	c, from := newOfflineClient(t)
+	c.AuthQuery = fakeAuthQuery{}
+	txClient := &fakeTxClient{broadcastErr: status.Error(codes.Unavailable, "connection refused")}
+	c.TxClient = txClient
+	c.GetAccountRetriever().QueryClient = c.GClient
+
+	// the policy of ctx overrides the default one, which does not retry an unavailable node
+	policy := RetryPolicy{Attempts: 4, Delay: time.Millisecond, Retryable: []error{types.ErrUnavailable}}
+	msg := banktypes.MsgSend{FromAddress: from.String(), ToAddress: from.String(), Amount: sdk.NewCoins(sdk.NewCoin("atele", sdk.NewInt(1)))}
+	_, err := c.SendCtx(ContextWithRetryPolicy(context.Background(), policy), msg, fixedFee)
+	require.ErrorIs(t, err, types.ErrUnavailable)
+	require.Equal(t, 4, txClient.broadcasts)
+
+	txClient.broadcasts = 0
+	_, err = c.Send(msg, fixedFee)
+	require.ErrorIs(t, err, types.ErrUnavailable)
+	require.Equal(t, 1, txClient.broadcasts)
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// txSigner is a signer of a tx with its key, account number and sequence.
+type txSigner struct {
+	address       sdk.AccAddress
+	name          string
+	pubKey        cryptotypes.PubKey
+	accountNumber uint64
+	sequence      uint64
+}
+
+// txSigners returns the signers required by msgs in the order the tx expects their signatures.
+// The first one pays the fee.
+func (client *TeleportClient) txSigners(msgs ...sdk.Msg) ([]txSigner, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("no message to send")
+	}
+	if client.ctx.Keyring == nil {
+		return nil, errors.New("keyring must be imported")
+	}
+
+	var signers []txSigner
+	seen := make(map[string]bool)
+	for _, msg := range msgs {
+		for _, addr := range msg.GetSigners() {
+			if seen[addr.String()] {
+				continue
+			}
+			seen[addr.String()] = true
+
+			info, err := client.ctx.Keyring.KeyByAddress(addr)
+			if err != nil {
+				return nil, fmt.Errorf("signer %s: %w", addr, err)
+			}
+			signers = append(signers, txSigner{address: addr, name: info.GetName(), pubKey: info.GetPubKey()})
+		}
+	}
+	return signers, nil
+}
+
+// withSequences sets the account numbers and the next sequences of the signers while fn runs,
+// see types.SequenceManager.DoAll.
+func (client *TeleportClient) withSequences(ctx context.Context, signers []txSigner, fn func() (used bool, err error)) error {
+	addrs := make([]sdk.AccAddress, len(signers))
+	for i, s := range signers {
+		addrs[i] = s.address
+	}
+	return client.sequences.DoAll(ctx, client.ctx, addrs, func(accountNumbers, sequences []uint64) (bool, error) {
+		for i := range signers {
+			signers[i].accountNumber, signers[i].sequence = accountNumbers[i], sequences[i]
+		}
+		return fn()
+	})
+}
+
+// signModeFor returns the factory with a sign mode able to sign for all signers,
+// since SIGN_MODE_DIRECT only supports a single signer.
+func signModeFor(txf sdktx.Factory, signers []txSigner) sdktx.Factory {
+	if len(signers) > 1 && txf.SignMode() == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		return txf.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+	return txf
+}
+
+// signTx signs the tx with the keys of all signers, in order.
+func signTx(txf sdktx.Factory, txBuilder sdkclient.TxBuilder, signers []txSigner) error {
+	txf = signModeFor(txf, signers)
+	for i, s := range signers {
+		if err := sdktx.Sign(txf.WithAccountNumber(s.accountNumber).WithSequence(s.sequence), s.name, txBuilder, i == 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildSimTx is like sdktx.BuildSimTx, with an empty signature of each signer.
+func (client *TeleportClient) buildSimTx(txf sdktx.Factory, signers []txSigner, msgs ...sdk.Msg) ([]byte, error) {
+	txf = signModeFor(txf, signers)
+	txBuilder, err := sdktx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([]signing.SignatureV2, len(signers))
+	for i, s := range signers {
+		sigs[i] = signing.SignatureV2{
+			PubKey:   s.pubKey,
+			Data:     &signing.SingleSignatureData{SignMode: txf.SignMode()},
+			Sequence: s.sequence,
+		}
+	}
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	return client.ctx.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
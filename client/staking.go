@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/teleport-network/teleport-sdk-go/grpc"
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+func (client *TeleportClient) Delegate(msg stakingtypes.MsgDelegate, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.DelegateCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) DelegateCtx(ctx context.Context, msg stakingtypes.MsgDelegate, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.DelegatorAddress); err != nil {
+		return nil, err
+	}
+	if err := toValBech32(&msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+func (client *TeleportClient) Undelegate(msg stakingtypes.MsgUndelegate, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.UndelegateCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) UndelegateCtx(ctx context.Context, msg stakingtypes.MsgUndelegate, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.DelegatorAddress); err != nil {
+		return nil, err
+	}
+	if err := toValBech32(&msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+func (client *TeleportClient) BeginRedelegate(msg stakingtypes.MsgBeginRedelegate, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.BeginRedelegateCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) BeginRedelegateCtx(ctx context.Context, msg stakingtypes.MsgBeginRedelegate, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.DelegatorAddress); err != nil {
+		return nil, err
+	}
+	if err := toValBech32(&msg.ValidatorSrcAddress, &msg.ValidatorDstAddress); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// CreateValidator broadcasts msg, signed by the delegator and, if it is another account, by the validator.
+func (client *TeleportClient) CreateValidator(msg stakingtypes.MsgCreateValidator, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.CreateValidatorCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) CreateValidatorCtx(ctx context.Context, msg stakingtypes.MsgCreateValidator, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toBech32(&msg.DelegatorAddress); err != nil {
+		return nil, err
+	}
+	if err := toValBech32(&msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+func (client *TeleportClient) EditValidator(msg stakingtypes.MsgEditValidator, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.EditValidatorCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) EditValidatorCtx(ctx context.Context, msg stakingtypes.MsgEditValidator, options ...Option) (*tx.BroadcastTxResponse, error) {
+	if err := toValBech32(&msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
+	if err != nil {
+		return nil, err
+	}
+	return client.BroadcastCtx(ctx, txf, &msg)
+}
+
+// Validators queries the validators with the given status, one of stakingtypes.BondStatus names, through all pages.
+// An empty status queries all validators.
+func (client *TeleportClient) Validators(status string) ([]stakingtypes.Validator, error) {
+	return client.ValidatorsCtx(context.Background(), status)
+}
+
+func (client *TeleportClient) ValidatorsCtx(ctx context.Context, status string) ([]stakingtypes.Validator, error) {
+	var validators []stakingtypes.Validator
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.StakingQuery.Validators(ctx, &stakingtypes.QueryValidatorsRequest{Status: status, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		validators = append(validators, res.Validators...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return validators, nil
+}
+
+func (client *TeleportClient) Validator(validator string) (stakingtypes.Validator, error) {
+	return client.ValidatorCtx(context.Background(), validator)
+}
+
+func (client *TeleportClient) ValidatorCtx(ctx context.Context, validator string) (stakingtypes.Validator, error) {
+	if err := toValBech32(&validator); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+	res, err := client.StakingQuery.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: validator})
+	if err != nil {
+		return stakingtypes.Validator{}, types.WrapNodeError(err)
+	}
+	return res.Validator, nil
+}
+
+// ValidatorDelegations queries the delegations to the given validator, through all pages.
+func (client *TeleportClient) ValidatorDelegations(validator string) (stakingtypes.DelegationResponses, error) {
+	return client.ValidatorDelegationsCtx(context.Background(), validator)
+}
+
+func (client *TeleportClient) ValidatorDelegationsCtx(ctx context.Context, validator string) (stakingtypes.DelegationResponses, error) {
+	if err := toValBech32(&validator); err != nil {
+		return nil, err
+	}
+	var delegations stakingtypes.DelegationResponses
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.StakingQuery.ValidatorDelegations(ctx, &stakingtypes.QueryValidatorDelegationsRequest{ValidatorAddr: validator, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		delegations = append(delegations, res.DelegationResponses...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+func (client *TeleportClient) Delegation(delegator, validator string) (stakingtypes.DelegationResponse, error) {
+	return client.DelegationCtx(context.Background(), delegator, validator)
+}
+
+func (client *TeleportClient) DelegationCtx(ctx context.Context, delegator, validator string) (stakingtypes.DelegationResponse, error) {
+	if err := toBech32(&delegator); err != nil {
+		return stakingtypes.DelegationResponse{}, err
+	}
+	if err := toValBech32(&validator); err != nil {
+		return stakingtypes.DelegationResponse{}, err
+	}
+	res, err := client.StakingQuery.Delegation(ctx, &stakingtypes.QueryDelegationRequest{DelegatorAddr: delegator, ValidatorAddr: validator})
+	if err != nil {
+		return stakingtypes.DelegationResponse{}, types.WrapNodeError(err)
+	}
+	if res.DelegationResponse == nil {
+		return stakingtypes.DelegationResponse{}, fmt.Errorf("no delegation of %s to %s", delegator, validator)
+	}
+	return *res.DelegationResponse, nil
+}
+
+// Delegations queries the delegations of the given delegator, through all pages.
+func (client *TeleportClient) Delegations(delegator string) (stakingtypes.DelegationResponses, error) {
+	return client.DelegationsCtx(context.Background(), delegator)
+}
+
+func (client *TeleportClient) DelegationsCtx(ctx context.Context, delegator string) (stakingtypes.DelegationResponses, error) {
+	if err := toBech32(&delegator); err != nil {
+		return nil, err
+	}
+	var delegations stakingtypes.DelegationResponses
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.StakingQuery.DelegatorDelegations(ctx, &stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: delegator, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		delegations = append(delegations, res.DelegationResponses...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+func (client *TeleportClient) UnbondingDelegation(delegator, validator string) (stakingtypes.UnbondingDelegation, error) {
+	return client.UnbondingDelegationCtx(context.Background(), delegator, validator)
+}
+
+func (client *TeleportClient) UnbondingDelegationCtx(ctx context.Context, delegator, validator string) (stakingtypes.UnbondingDelegation, error) {
+	if err := toBech32(&delegator); err != nil {
+		return stakingtypes.UnbondingDelegation{}, err
+	}
+	if err := toValBech32(&validator); err != nil {
+		return stakingtypes.UnbondingDelegation{}, err
+	}
+	res, err := client.StakingQuery.UnbondingDelegation(ctx, &stakingtypes.QueryUnbondingDelegationRequest{DelegatorAddr: delegator, ValidatorAddr: validator})
+	if err != nil {
+		return stakingtypes.UnbondingDelegation{}, types.WrapNodeError(err)
+	}
+	return res.Unbond, nil
+}
+
+// UnbondingDelegations queries the unbonding delegations of the given delegator with their entries, through all pages.
+func (client *TeleportClient) UnbondingDelegations(delegator string) ([]stakingtypes.UnbondingDelegation, error) {
+	return client.UnbondingDelegationsCtx(context.Background(), delegator)
+}
+
+func (client *TeleportClient) UnbondingDelegationsCtx(ctx context.Context, delegator string) ([]stakingtypes.UnbondingDelegation, error) {
+	if err := toBech32(&delegator); err != nil {
+		return nil, err
+	}
+	var unbondings []stakingtypes.UnbondingDelegation
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.StakingQuery.DelegatorUnbondingDelegations(ctx, &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{DelegatorAddr: delegator, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		unbondings = append(unbondings, res.UnbondingResponses...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return unbondings, nil
+}
+
+// Redelegations queries the redelegations of the given delegator with their entries, through all pages.
+func (client *TeleportClient) Redelegations(delegator string) (stakingtypes.RedelegationResponses, error) {
+	return client.RedelegationsCtx(context.Background(), delegator)
+}
+
+func (client *TeleportClient) RedelegationsCtx(ctx context.Context, delegator string) (stakingtypes.RedelegationResponses, error) {
+	if err := toBech32(&delegator); err != nil {
+		return nil, err
+	}
+	var redelegations stakingtypes.RedelegationResponses
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.StakingQuery.Redelegations(ctx, &stakingtypes.QueryRedelegationsRequest{DelegatorAddr: delegator, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		redelegations = append(redelegations, res.RedelegationResponses...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return redelegations, nil
+}
+
+func (client *TeleportClient) StakingParams() (stakingtypes.Params, error) {
+	return client.StakingParamsCtx(context.Background())
+}
+
+func (client *TeleportClient) StakingParamsCtx(ctx context.Context) (stakingtypes.Params, error) {
+	res, err := client.StakingQuery.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return stakingtypes.Params{}, types.WrapNodeError(err)
+	}
+	return res.Params, nil
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeStakingQuery serves validators and delegations in pages, and records the addresses it is queried for.
+type fakeStakingQuery struct {
+	stakingtypes.QueryClient
+
+	validators  []stakingtypes.Validator
+	delegations stakingtypes.DelegationResponses
+	delegator   string
+	validator   string
+	pages       int
+}
+
+func (q *fakeStakingQuery) Validators(_ context.Context, req *stakingtypes.QueryValidatorsRequest, _ ...grpc.CallOption) (*stakingtypes.QueryValidatorsResponse, error) {
+	q.pages++
+	start, end, pageRes := page(req.Pagination, len(q.validators))
+	return &stakingtypes.QueryValidatorsResponse{Validators: q.validators[start:end], Pagination: pageRes}, nil
+}
+
+func (q *fakeStakingQuery) Validator(_ context.Context, req *stakingtypes.QueryValidatorRequest, _ ...grpc.CallOption) (*stakingtypes.QueryValidatorResponse, error) {
+	q.validator = req.ValidatorAddr
+	return &stakingtypes.QueryValidatorResponse{Validator: stakingtypes.Validator{OperatorAddress: req.ValidatorAddr}}, nil
+}
+
+func (q *fakeStakingQuery) ValidatorDelegations(_ context.Context, req *stakingtypes.QueryValidatorDelegationsRequest, _ ...grpc.CallOption) (*stakingtypes.QueryValidatorDelegationsResponse, error) {
+	q.validator = req.ValidatorAddr
+	q.pages++
+	start, end, pageRes := page(req.Pagination, len(q.delegations))
+	return &stakingtypes.QueryValidatorDelegationsResponse{DelegationResponses: q.delegations[start:end], Pagination: pageRes}, nil
+}
+
+func (q *fakeStakingQuery) DelegatorDelegations(_ context.Context, req *stakingtypes.QueryDelegatorDelegationsRequest, _ ...grpc.CallOption) (*stakingtypes.QueryDelegatorDelegationsResponse, error) {
+	q.delegator = req.DelegatorAddr
+	q.pages++
+	start, end, pageRes := page(req.Pagination, len(q.delegations))
+	return &stakingtypes.QueryDelegatorDelegationsResponse{DelegationResponses: q.delegations[start:end], Pagination: pageRes}, nil
+}
+
+func (q *fakeStakingQuery) Delegation(_ context.Context, req *stakingtypes.QueryDelegationRequest, _ ...grpc.CallOption) (*stakingtypes.QueryDelegationResponse, error) {
+	q.delegator, q.validator = req.DelegatorAddr, req.ValidatorAddr
+	for _, delegation := range q.delegations {
+		if delegation.Delegation.ValidatorAddress == req.ValidatorAddr {
+			return &stakingtypes.QueryDelegationResponse{DelegationResponse: &delegation}, nil
+		}
+	}
+	return &stakingtypes.QueryDelegationResponse{}, nil
+}
+
+func TestStakingQueries(t *testing.T) {
+	c, from := newOfflineClient(t)
+	hex := common.BytesToAddress(from).Hex()
+	valAddrs := []sdk.ValAddress{val1, val2, sdk.ValAddress("val3________________")}
+	stakingQuery := &fakeStakingQuery{}
+	for _, valAddr := range valAddrs {
+		stakingQuery.validators = append(stakingQuery.validators, stakingtypes.Validator{OperatorAddress: valAddr.String()})
+		stakingQuery.delegations = append(stakingQuery.delegations, stakingtypes.NewDelegationResp(from, valAddr, sdk.NewDec(1), sdk.NewInt64Coin("atele", 1)))
+	}
+	c.StakingQuery = stakingQuery
+
+	validators, err := c.Validators("")
+	require.NoError(t, err)
+	require.Equal(t, stakingQuery.validators, validators)
+	require.Equal(t, 2, stakingQuery.pages)
+
+	// the hex form of a validator is the hex form of its operator account
+	validator, err := c.Validator(common.BytesToAddress(val1).Hex())
+	require.NoError(t, err)
+	require.Equal(t, val1.String(), validator.OperatorAddress)
+
+	stakingQuery.pages = 0
+	delegations, err := c.ValidatorDelegations(common.BytesToAddress(val2).Hex())
+	require.NoError(t, err)
+	require.Equal(t, stakingQuery.delegations, delegations)
+	require.Equal(t, val2.String(), stakingQuery.validator)
+	require.Equal(t, 2, stakingQuery.pages)
+
+	stakingQuery.pages = 0
+	delegations, err = c.Delegations(hex)
+	require.NoError(t, err)
+	require.Equal(t, stakingQuery.delegations, delegations)
+	require.Equal(t, from.String(), stakingQuery.delegator)
+	require.Equal(t, 2, stakingQuery.pages)
+
+	delegation, err := c.Delegation(hex, val2.String())
+	require.NoError(t, err)
+	require.Equal(t, val2.String(), delegation.Delegation.ValidatorAddress)
+	_, err = c.Delegation(hex, sdk.ValAddress("val4________________").String())
+	require.Error(t, err)
+}
+
+func TestStakingMsgs(t *testing.T) {
+	c, from, txClient := newBroadcastClient(t)
+	hex := common.BytesToAddress(from).Hex()
+	amount := sdk.NewInt64Coin("atele", 5)
+
+	_, err := c.Delegate(stakingtypes.MsgDelegate{DelegatorAddress: hex, ValidatorAddress: common.BytesToAddress(val1).Hex(), Amount: amount}, fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{stakingtypes.NewMsgDelegate(from, val1, amount)}, txClient.lastMsgs(t, c))
+
+	_, err = c.Undelegate(stakingtypes.MsgUndelegate{DelegatorAddress: hex, ValidatorAddress: val1.String(), Amount: amount}, fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{stakingtypes.NewMsgUndelegate(from, val1, amount)}, txClient.lastMsgs(t, c))
+
+	_, err = c.BeginRedelegate(stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    hex,
+		ValidatorSrcAddress: common.BytesToAddress(val1).Hex(),
+		ValidatorDstAddress: val2.String(),
+		Amount:              amount,
+	}, fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{stakingtypes.NewMsgBeginRedelegate(from, val1, val2, amount)}, txClient.lastMsgs(t, c))
+
+	_, err = c.EditValidator(stakingtypes.MsgEditValidator{Description: stakingtypes.Description{Moniker: "node"}, ValidatorAddress: hex}, fixedFee)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{stakingtypes.NewMsgEditValidator(sdk.ValAddress(from), stakingtypes.Description{Moniker: "node"}, nil, nil)}, txClient.lastMsgs(t, c))
+}
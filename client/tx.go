@@ -5,9 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"strings"
-
-	"github.com/avast/retry-go"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
@@ -26,13 +23,15 @@ func Prepare(client *TeleportClient, signer sdk.AccAddress, msg sdk.Msg, options
 	if client.ctx.Keyring == nil {
 		return sdktx.Factory{}, errors.New("keyring must be imported")
 	}
-	info, err := client.ctx.Keyring.KeyByAddress(signer)
-	if err != nil {
+	if _, err := client.ctx.Keyring.KeyByAddress(signer); err != nil {
 		return sdktx.Factory{}, err
 	}
-	client.ctx.FromAddress = signer
-	client.ctx.FromName = info.GetName()
+	return client.NewFactory(options...), nil
+}
 
+// NewFactory returns a tx factory with the settings of the client and the options applied.
+// Unlike Prepare it does not check any message or signer.
+func (client *TeleportClient) NewFactory(options ...Option) sdktx.Factory {
 	txf := sdktx.Factory{}.
 		WithChainID(client.ctx.ChainID).
 		WithTxConfig(client.ctx.TxConfig).
@@ -44,44 +43,52 @@ func Prepare(client *TeleportClient, signer sdk.AccAddress, msg sdk.Msg, options
 	if txf.Gas() == 0 {
 		txf = txf.WithSimulateAndExecute(true)
 	}
-	return txf, nil
+	return txf
 }
 
 // Broadcast Sign and broadcast to node. It is retryable.
-func (client *TeleportClient) Broadcast(txf sdktx.Factory, msgs ...sdk.Msg) (res *tx.BroadcastTxResponse, err error) {
-	retryableFunc := func() error {
-		txf, err := SetupAccNumberSequence(client.ctx, client.accountRetriever, txf)
-		if err != nil {
-			return err
-		}
-		res, err = client.broadcast(txf, msgs...)
-		if err == nil && res.TxResponse.Code == 0 {
-			client.accountRetriever.IncreaseSequence(client.ctx.FromAddress)
-		}
-		return err
-	}
+// The tx is signed by every signer required by msgs, whose keys must be in the keyring.
+// A tx rejected by the node is returned together with a *types.TxError, and every error
+// can be matched against the typed errors of the types package with errors.Is.
+func (client *TeleportClient) Broadcast(txf sdktx.Factory, msgs ...sdk.Msg) (*tx.BroadcastTxResponse, error) {
+	return client.BroadcastCtx(context.Background(), txf, msgs...)
+}
 
-	retryIfFunc := func(err error) bool {
-		return strings.Contains(err.Error(), "account sequence mismatch")
-	}
+// BroadcastCtx is like Broadcast but carries ctx through every node request.
+// A cancelled ctx aborts the pending retries with its error, and ContextWithRetryPolicy overrides the retry policy.
+func (client *TeleportClient) BroadcastCtx(ctx context.Context, txf sdktx.Factory, msgs ...sdk.Msg) (*tx.BroadcastTxResponse, error) {
+	return client.broadcastWithRetry(ctx, client.ctx.BroadcastMode, txf, msgs...)
+}
 
-	onRetryFunc := func(n uint, err error) {
-		client.accountRetriever.RemoveCache(client.ctx.FromAddress)
+func (client *TeleportClient) broadcastWithRetry(ctx context.Context, mode string, txf sdktx.Factory, msgs ...sdk.Msg) (*tx.BroadcastTxResponse, error) {
+	signers, err := client.txSigners(msgs...)
+	if err != nil {
+		return nil, err
 	}
 
-	err = retry.Do(
-		retryableFunc,
-		retry.Attempts(3),
-		retry.RetryIf(retryIfFunc),
-		retry.OnRetry(onRetryFunc),
-	)
-
-	return
+	var res *tx.BroadcastTxResponse
+	retryableFunc := func() error {
+		return client.withSequences(ctx, signers, func() (bool, error) {
+			var err error
+			res, err = client.broadcast(ctx, mode, txf, signers, msgs...)
+			if err != nil {
+				return false, err
+			}
+			if res.TxResponse.Code != 0 {
+				// a tx failing in DeliverTx, as reported in block mode, still consumes its sequence
+				return res.TxResponse.Height > 0, types.NewTxError(res.TxResponse)
+			}
+			return true, nil
+		})
+	}
+
+	err = client.retryPolicy(ctx).do(ctx, retryableFunc)
+	return res, err
 }
 
-func (client *TeleportClient) broadcast(txf sdktx.Factory, msgs ...sdk.Msg) (*tx.BroadcastTxResponse, error) {
+func (client *TeleportClient) broadcast(ctx context.Context, mode string, txf sdktx.Factory, signers []txSigner, msgs ...sdk.Msg) (*tx.BroadcastTxResponse, error) {
 	if txf.SimulateAndExecute() {
-		_, adjusted, err := client.calculateGas(txf, msgs...)
+		_, adjusted, err := client.calculateGas(ctx, txf, signers, msgs...)
 		if err != nil {
 			return nil, err
 		}
@@ -89,13 +96,19 @@ func (client *TeleportClient) broadcast(txf sdktx.Factory, msgs ...sdk.Msg) (*tx
 		txf = txf.WithGas(adjusted)
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", sdktx.GasEstimateResponse{GasEstimate: txf.Gas()})
 	}
+	if client.feeConfig != nil && txf.Fees().IsZero() && txf.GasPrices().IsZero() {
+		gasPrice, err := client.SuggestGasPriceCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithFees(fees(gasPrice, txf.Gas()).String())
+	}
 	txBuilder, err := sdktx.BuildUnsignedTx(txf, msgs...)
 	if err != nil {
 		return nil, err
 	}
 
-	err = sdktx.Sign(txf, client.ctx.GetFromName(), txBuilder, true)
-	if err != nil {
+	if err := signTx(txf, txBuilder, signers); err != nil {
 		return nil, err
 	}
 
@@ -104,48 +117,44 @@ func (client *TeleportClient) broadcast(txf sdktx.Factory, msgs ...sdk.Msg) (*tx
 		return nil, err
 	}
 
-	res, err := client.BroadcastTx(txBytes)
+	res, err := client.broadcastTx(ctx, mode, txBytes)
 
 	return res, err
 }
 
 // CalculateGas simulation response obtained by the query and the adjusted gas amount
 // it is retryable
-func (client *TeleportClient) CalculateGas(txf sdktx.Factory, msgs ...sdk.Msg) (res *tx.SimulateResponse, gas uint64, err error) {
-	retryableFunc := func() error {
-		txf, err = SetupAccNumberSequence(client.ctx, client.accountRetriever, txf)
-		if err != nil {
-			return err
-		}
-		res, gas, err = client.calculateGas(txf, msgs...)
-		return err
-	}
+func (client *TeleportClient) CalculateGas(txf sdktx.Factory, msgs ...sdk.Msg) (*tx.SimulateResponse, uint64, error) {
+	return client.CalculateGasCtx(context.Background(), txf, msgs...)
+}
 
-	retryIfFunc := func(err error) bool {
-		return strings.Contains(err.Error(), "account sequence mismatch")
+// CalculateGasCtx is like CalculateGas but carries ctx through every node request.
+// A cancelled ctx aborts the pending retries with its error, and ContextWithRetryPolicy overrides the retry policy.
+func (client *TeleportClient) CalculateGasCtx(ctx context.Context, txf sdktx.Factory, msgs ...sdk.Msg) (res *tx.SimulateResponse, gas uint64, err error) {
+	signers, err := client.txSigners(msgs...)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	onRetryFunc := func(n uint, err error) {
-		client.accountRetriever.RemoveCache(client.ctx.FromAddress)
+	retryableFunc := func() error {
+		return client.withSequences(ctx, signers, func() (bool, error) {
+			var err error
+			res, gas, err = client.calculateGas(ctx, txf, signers, msgs...)
+			return false, err
+		})
 	}
 
-	err = retry.Do(
-		retryableFunc,
-		retry.Attempts(3),
-		retry.RetryIf(retryIfFunc),
-		retry.OnRetry(onRetryFunc),
-	)
-
+	err = client.retryPolicy(ctx).do(ctx, retryableFunc)
 	return
 }
 
-func (client *TeleportClient) calculateGas(txf sdktx.Factory, msgs ...sdk.Msg) (*tx.SimulateResponse, uint64, error) {
-	txBytes, err := sdktx.BuildSimTx(txf, msgs...)
+func (client *TeleportClient) calculateGas(ctx context.Context, txf sdktx.Factory, signers []txSigner, msgs ...sdk.Msg) (*tx.SimulateResponse, uint64, error) {
+	txBytes, err := client.buildSimTx(txf, signers, msgs...)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	simRes, err := client.Simulate(txBytes)
+	simRes, err := client.SimulateCtx(ctx, txBytes)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -154,28 +163,50 @@ func (client *TeleportClient) calculateGas(txf sdktx.Factory, msgs ...sdk.Msg) (
 }
 
 func (client *TeleportClient) Simulate(txBytes []byte) (*tx.SimulateResponse, error) {
-	return client.TxClient.Simulate(
-		context.Background(),
+	return client.SimulateCtx(context.Background(), txBytes)
+}
+
+func (client *TeleportClient) SimulateCtx(ctx context.Context, txBytes []byte) (*tx.SimulateResponse, error) {
+	res, err := client.TxClient.Simulate(
+		ctx,
 		&tx.SimulateRequest{TxBytes: txBytes},
 	)
+	return res, types.WrapNodeError(err)
 }
 
 func (client *TeleportClient) BroadcastTx(txBytes []byte) (*tx.BroadcastTxResponse, error) {
-	return client.TxClient.BroadcastTx(
-		context.Background(),
+	return client.BroadcastTxCtx(context.Background(), txBytes)
+}
+
+func (client *TeleportClient) BroadcastTxCtx(ctx context.Context, txBytes []byte) (*tx.BroadcastTxResponse, error) {
+	return client.broadcastTx(ctx, client.ctx.BroadcastMode, txBytes)
+}
+
+func (client *TeleportClient) broadcastTx(ctx context.Context, mode string, txBytes []byte) (*tx.BroadcastTxResponse, error) {
+	res, err := client.TxClient.BroadcastTx(
+		ctx,
 		&tx.BroadcastTxRequest{
 			TxBytes: txBytes,
-			Mode:    convertBroadcastMode(client.ctx.BroadcastMode),
+			Mode:    convertBroadcastMode(mode),
 		},
 	)
+	return res, types.WrapNodeError(err)
 }
 
 func (client *TeleportClient) GetTx(hash string) (*tx.GetTxResponse, error) {
-	return client.TxClient.GetTx(context.Background(), &tx.GetTxRequest{Hash: hash})
+	return client.GetTxCtx(context.Background(), hash)
+}
+
+func (client *TeleportClient) GetTxCtx(ctx context.Context, hash string) (*tx.GetTxResponse, error) {
+	return client.TxClient.GetTx(ctx, &tx.GetTxRequest{Hash: hash})
 }
 
 func (client *TeleportClient) GetTxsEvent(req *tx.GetTxsEventRequest) (*tx.GetTxsEventResponse, error) {
-	return client.TxClient.GetTxsEvent(context.Background(), req)
+	return client.GetTxsEventCtx(context.Background(), req)
+}
+
+func (client *TeleportClient) GetTxsEventCtx(ctx context.Context, req *tx.GetTxsEventRequest) (*tx.GetTxsEventResponse, error) {
+	return client.TxClient.GetTxsEvent(ctx, req)
 }
 
 // to be passed into the clientCtx.
@@ -192,18 +223,19 @@ func convertBroadcastMode(mode string) tx.BroadcastMode {
 	}
 }
 
-// SetupAccNumberSequence ensures the account defined by ctx.GetFromAddress() exists and
-// if the account number and/or the account sequence number are zero (not set),
-// they will be queried for and set on the provided Factory. A new Factory with
-// the updated fields will be returned.
-func SetupAccNumberSequence(clientCtx client.Context, accountRetriever *types.AccountRetriever, txf sdktx.Factory) (sdktx.Factory, error) {
-	from := clientCtx.GetFromAddress()
+// SetupAccNumberSequence ensures the account of from exists and sets its account number and sequence,
+// queried by accountRetriever, on the provided Factory. A new Factory with the updated fields is returned.
+func SetupAccNumberSequence(clientCtx client.Context, accountRetriever *types.AccountRetriever, from sdk.AccAddress, txf sdktx.Factory) (sdktx.Factory, error) {
+	return SetupAccNumberSequenceCtx(context.Background(), clientCtx, accountRetriever, from, txf)
+}
 
-	if err := accountRetriever.EnsureExists(clientCtx, from); err != nil {
+// SetupAccNumberSequenceCtx is like SetupAccNumberSequence but queries the node with the given context.
+func SetupAccNumberSequenceCtx(ctx context.Context, clientCtx client.Context, accountRetriever *types.AccountRetriever, from sdk.AccAddress, txf sdktx.Factory) (sdktx.Factory, error) {
+	if err := accountRetriever.EnsureExistsCtx(ctx, clientCtx, from); err != nil {
 		return txf, err
 	}
 
-	num, seq, err := accountRetriever.GetAccountNumberSequence(clientCtx, from)
+	num, seq, err := accountRetriever.GetAccountNumberSequenceCtx(ctx, clientCtx, from)
 	if err != nil {
 		return txf, err
 	}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// TxBuilder collects messages of any module to broadcast them atomically in a single tx.
+// The tx is signed by every signer the messages require, and the first one pays the fee.
+type TxBuilder struct {
+	client  *TeleportClient
+	msgs    []sdk.Msg
+	options []Option
+}
+
+// NewTxBuilder returns an empty TxBuilder whose tx is built with the given options.
+func (client *TeleportClient) NewTxBuilder(options ...Option) *TxBuilder {
+	return &TxBuilder{client: client, options: options}
+}
+
+// AddMsgs appends msgs to the tx.
+func (b *TxBuilder) AddMsgs(msgs ...sdk.Msg) *TxBuilder {
+	b.msgs = append(b.msgs, msgs...)
+	return b
+}
+
+// WithOptions appends options to the options of the tx.
+func (b *TxBuilder) WithOptions(options ...Option) *TxBuilder {
+	b.options = append(b.options, options...)
+	return b
+}
+
+func (b *TxBuilder) Msgs() []sdk.Msg {
+	return b.msgs
+}
+
+// Signers returns the signers required by the messages, in the order of their signatures.
+func (b *TxBuilder) Signers() []sdk.AccAddress {
+	var signers []sdk.AccAddress
+	seen := make(map[string]bool)
+	for _, msg := range b.msgs {
+		for _, addr := range msg.GetSigners() {
+			if !seen[addr.String()] {
+				seen[addr.String()] = true
+				signers = append(signers, addr)
+			}
+		}
+	}
+	return signers
+}
+
+// Validate checks every message and that the keys of all signers are in the keyring.
+func (b *TxBuilder) Validate() error {
+	if len(b.msgs) == 0 {
+		return errors.New("no message to send")
+	}
+	for _, msg := range b.msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	_, err := b.client.txSigners(b.msgs...)
+	return err
+}
+
+// CalculateGas simulates the tx once for all messages and returns the adjusted gas amount.
+func (b *TxBuilder) CalculateGas() (*tx.SimulateResponse, uint64, error) {
+	return b.CalculateGasCtx(context.Background())
+}
+
+func (b *TxBuilder) CalculateGasCtx(ctx context.Context) (*tx.SimulateResponse, uint64, error) {
+	if err := b.Validate(); err != nil {
+		return nil, 0, err
+	}
+	return b.client.CalculateGasCtx(ctx, b.client.NewFactory(b.options...), b.msgs...)
+}
+
+// Broadcast signs and broadcasts the messages in a single tx, see TeleportClient.Broadcast.
+func (b *TxBuilder) Broadcast() (*tx.BroadcastTxResponse, error) {
+	return b.BroadcastCtx(context.Background())
+}
+
+func (b *TxBuilder) BroadcastCtx(ctx context.Context) (*tx.BroadcastTxResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b.client.BroadcastCtx(ctx, b.client.NewFactory(b.options...), b.msgs...)
+}
+
+// BroadcastAndWait signs and broadcasts the messages in a single tx and waits until it is committed,
+// see TeleportClient.BroadcastAndWait.
+func (b *TxBuilder) BroadcastAndWait() (*TxResult, error) {
+	return b.BroadcastAndWaitCtx(context.Background())
+}
+
+func (b *TxBuilder) BroadcastAndWaitCtx(ctx context.Context) (*TxResult, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b.client.BroadcastAndWaitCtx(ctx, b.client.NewFactory(b.options...), b.msgs...)
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tharsis/ethermint/crypto/hd"
+)
+
+func TestTxBuilder(t *testing.T) {
+	c, from, txClient := newBroadcastClient(t)
+	info, _, err := c.ctx.Keyring.NewMnemonic("acc2", keyring.English, sdk.GetConfig().GetFullBIP44Path(), "", hd.EthSecp256k1)
+	require.NoError(t, err)
+	other := info.GetAddress()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("atele", 1))
+
+	// the signers are ordered by their first message, and the first one pays the fee
+	b := c.NewTxBuilder(fixedFee).AddMsgs(
+		banktypes.NewMsgSend(other, from, amount),
+		banktypes.NewMsgSend(from, other, amount),
+		banktypes.NewMsgSend(other, from, amount),
+	)
+	require.Equal(t, []sdk.AccAddress{other, from}, b.Signers())
+	signers, err := c.txSigners(b.Msgs()...)
+	require.NoError(t, err)
+	require.Len(t, signers, 2)
+	require.Equal(t, "acc2", signers[0].name)
+	require.Equal(t, "acc1", signers[1].name)
+
+	_, err = b.Broadcast()
+	require.NoError(t, err)
+	decoded, err := c.ctx.TxConfig.TxDecoder()(txClient.txs[0])
+	require.NoError(t, err)
+	signedTx := decoded.(authsigning.Tx)
+	require.Equal(t, []sdk.AccAddress{other, from}, signedTx.GetSigners())
+	require.Equal(t, other, signedTx.FeePayer())
+	sigs, err := signedTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+	for i, sig := range sigs {
+		require.Equal(t, signers[i].pubKey, sig.PubKey)
+		require.Equal(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, sig.Data.(*signing.SingleSignatureData).SignMode)
+	}
+}
+
+func TestSignModeFor(t *testing.T) {
+	c, from := newOfflineClient(t)
+	one, err := c.txSigners(banktypes.NewMsgSend(from, from, nil))
+	require.NoError(t, err)
+	two := append(one, txSigner{address: sdk.AccAddress("other_______________")})
+
+	// a tx of several signers is signed in amino json unless a sign mode is set
+	txf := c.NewFactory()
+	require.Equal(t, signing.SignMode_SIGN_MODE_UNSPECIFIED, signModeFor(txf, one).SignMode())
+	require.Equal(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signModeFor(txf, two).SignMode())
+	txf = txf.WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+	require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, signModeFor(txf, two).SignMode())
+}
+
+func TestTxBuilderValidate(t *testing.T) {
+	c, from, txClient := newBroadcastClient(t)
+
+	_, err := c.NewTxBuilder(fixedFee).Broadcast()
+	require.EqualError(t, err, "no message to send")
+
+	// every signer must have its key in the keyring
+	unknown := sdk.AccAddress("unknown_____________")
+	b := c.NewTxBuilder(fixedFee).AddMsgs(banktypes.NewMsgSend(unknown, from, sdk.NewCoins(sdk.NewInt64Coin("atele", 1))))
+	require.Error(t, b.Validate())
+
+	// an invalid message is rejected without broadcasting anything
+	b = c.NewTxBuilder(fixedFee).AddMsgs(banktypes.NewMsgSend(from, from, sdk.Coins{}))
+	require.Error(t, b.Validate())
+	require.Zero(t, txClient.broadcasts)
+
+	_, err = c.NewTxBuilder().WithOptions(fixedFee).AddMsgs(banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin("atele", 1)))).Broadcast()
+	require.NoError(t, err)
+	require.Equal(t, 1, txClient.broadcasts)
+}
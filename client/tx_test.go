@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// fakeAuthQuery serves an account with number 7 and sequence 3 for any address.
+type fakeAuthQuery struct {
+	authtypes.QueryClient
+}
+
+func (fakeAuthQuery) Account(_ context.Context, req *authtypes.QueryAccountRequest, _ ...grpc.CallOption) (*authtypes.QueryAccountResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	any, err := codectypes.NewAnyWithValue(authtypes.NewBaseAccount(addr, nil, 7, 3))
+	return &authtypes.QueryAccountResponse{Account: any}, err
+}
+
+// fakeTxClient accepts every tx, unless broadcastErr is set, and counts the broadcasts.
+// onBroadcast, if set, is called on every broadcast.
+// A queried tx is committed with code after pending queries not finding it, or never if pending is negative.
+type fakeTxClient struct {
+	tx.ServiceClient
+
+	mu           sync.Mutex
+	broadcasts   int
+	txs          [][]byte
+	broadcastErr error
+	onBroadcast  func()
+	getTxs       int
+	pending      int
+	code         uint32
+	getTxErr     error
+}
+
+func (c *fakeTxClient) Simulate(context.Context, *tx.SimulateRequest, ...grpc.CallOption) (*tx.SimulateResponse, error) {
+	return &tx.SimulateResponse{GasInfo: &sdk.GasInfo{GasUsed: 100000}}, nil
+}
+
+func (c *fakeTxClient) BroadcastTx(_ context.Context, req *tx.BroadcastTxRequest, _ ...grpc.CallOption) (*tx.BroadcastTxResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.broadcasts++
+	c.txs = append(c.txs, req.TxBytes)
+	if c.onBroadcast != nil {
+		c.onBroadcast()
+	}
+	if c.broadcastErr != nil {
+		return nil, c.broadcastErr
+	}
+	return &tx.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: "ABCD"}}, nil
+}
+
+func (c *fakeTxClient) GetTx(_ context.Context, req *tx.GetTxRequest, _ ...grpc.CallOption) (*tx.GetTxResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.getTxs++
+	if c.getTxErr != nil {
+		return nil, c.getTxErr
+	}
+	if c.pending < 0 || c.getTxs <= c.pending {
+		return nil, status.Error(codes.NotFound, "tx not found")
+	}
+	return &tx.GetTxResponse{TxResponse: &sdk.TxResponse{TxHash: req.Hash, Height: 10, Code: c.code, Codespace: "sdk"}}, nil
+}
+
+// lastMsgs decodes the msgs of the last broadcast tx.
+func (c *fakeTxClient) lastMsgs(t *testing.T, client *TeleportClient) []sdk.Msg {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	require.NotEmpty(t, c.txs)
+	decoded, err := client.GetCtx().TxConfig.TxDecoder()(c.txs[len(c.txs)-1])
+	require.NoError(t, err)
+	return decoded.GetMsgs()
+}
+
+// newBroadcastClient returns an offline client broadcasting to a fake tx service, with the key of from.
+func newBroadcastClient(t *testing.T) (*TeleportClient, sdk.AccAddress, *fakeTxClient) {
+	c, from := newOfflineClient(t)
+	txClient := &fakeTxClient{}
+	c.AuthQuery = fakeAuthQuery{}
+	c.TxClient = txClient
+	c.GetAccountRetriever().QueryClient = c.GClient
+	return c, from, txClient
+}
+
+func fixedFee(txf sdktx.Factory) sdktx.Factory {
+	return txf.WithGas(200000).WithFees("100atele")
+}
+
+func TestSetupAccNumberSequenceAfterSend(t *testing.T) {
+	c, from := newOfflineClient(t)
+	c.AuthQuery = fakeAuthQuery{}
+	c.TxClient = &fakeTxClient{}
+	c.GetAccountRetriever().QueryClient = c.GClient
+
+	msg := banktypes.MsgSend{FromAddress: from.String(), ToAddress: from.String(), Amount: sdk.NewCoins(sdk.NewCoin("atele", sdk.NewInt(1)))}
+	for i := 0; i < 2; i++ {
+		_, err := c.Send(msg, fixedFee)
+		require.NoError(t, err)
+	}
+
+	txf, err := SetupAccNumberSequence(c.GetCtx(), c.GetAccountRetriever(), from, sdktx.Factory{})
+	require.NoError(t, err)
+	require.EqualValues(t, 7, txf.AccountNumber())
+	require.EqualValues(t, 5, txf.Sequence())
+
+	num, seq, err := c.GetAccountRetriever().GetAccountNumberSequence(c.GetCtx(), from)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, num)
+	require.EqualValues(t, 5, seq)
+}
+
+func TestBroadcastCancel(t *testing.T) {
+	c, from := newOfflineClient(t)
+	c.AuthQuery = fakeAuthQuery{}
+	c.GetAccountRetriever().QueryClient = c.GClient
+	c.WithRetryPolicy(RetryPolicy{Attempts: 10, Delay: time.Hour, Retryable: []error{types.ErrUnavailable}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	txClient := &fakeTxClient{broadcastErr: status.Error(codes.Unavailable, "connection refused"), onBroadcast: cancel}
+	c.TxClient = txClient
+
+	msg := banktypes.MsgSend{FromAddress: from.String(), ToAddress: from.String(), Amount: sdk.NewCoins(sdk.NewCoin("atele", sdk.NewInt(1)))}
+	start := time.Now()
+	_, err := c.SendCtx(ctx, msg, fixedFee)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, txClient.broadcasts)
+	require.Less(t, int64(time.Since(start)), int64(time.Minute))
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// WaitConfig defines how the client waits for a broadcast tx to be committed.
+type WaitConfig struct {
+	// PollInterval is the interval between two tx queries.
+	PollInterval time.Duration
+	// Timeout is the maximum time to wait for, zero means waiting until the context is done.
+	Timeout time.Duration
+	// MaxBlocks is the maximum number of blocks to wait for, zero means no limit.
+	MaxBlocks int64
+}
+
+// DefaultWaitConfig polls every second for at most one minute.
+var DefaultWaitConfig = WaitConfig{
+	PollInterval: time.Second,
+	Timeout:      time.Minute,
+}
+
+// TxResult is a committed tx with its decoded body.
+type TxResult struct {
+	*sdk.TxResponse
+	Tx *tx.Tx
+}
+
+// Events returns the events emitted by all messages of the tx.
+func (r *TxResult) Events() sdk.StringEvents {
+	var events sdk.StringEvents
+	for _, log := range r.Logs {
+		events = append(events, log.Events...)
+	}
+	return events
+}
+
+// Attributes returns all values of the attribute key in the events of the given type.
+func (r *TxResult) Attributes(eventType, key string) []string {
+	var values []string
+	for _, event := range r.Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == key {
+				values = append(values, attr.Value)
+			}
+		}
+	}
+	return values
+}
+
+// BroadcastAndWait signs and broadcasts the tx in sync mode, then waits until it is committed.
+// If the tx fails in DeliverTx, the result is returned together with a *types.TxError.
+func (client *TeleportClient) BroadcastAndWait(txf sdktx.Factory, msgs ...sdk.Msg) (*TxResult, error) {
+	return client.BroadcastAndWaitCtx(context.Background(), txf, msgs...)
+}
+
+func (client *TeleportClient) BroadcastAndWaitCtx(ctx context.Context, txf sdktx.Factory, msgs ...sdk.Msg) (*TxResult, error) {
+	res, err := client.broadcastWithRetry(ctx, flags.BroadcastSync, txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	return client.WaitForTxCtx(ctx, res.TxResponse.TxHash)
+}
+
+// WaitForTx polls the node until the tx of the given hash is committed or the wait limits elapse.
+// If the tx fails in DeliverTx, the result is returned together with a *types.TxError.
+func (client *TeleportClient) WaitForTx(hash string) (*TxResult, error) {
+	return client.WaitForTxCtx(context.Background(), hash)
+}
+
+func (client *TeleportClient) WaitForTxCtx(ctx context.Context, hash string) (*TxResult, error) {
+	cfg := client.waitConfig
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultWaitConfig.PollInterval
+	}
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+
+	var startHeight int64
+	if cfg.MaxBlocks > 0 {
+		height, err := client.latestHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+		startHeight = height
+	}
+
+	ticker := time.NewTicker(cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		res, err := client.GetTxCtx(ctx, hash)
+		if err == nil {
+			result := &TxResult{TxResponse: res.TxResponse, Tx: res.Tx}
+			if result.Code != 0 {
+				return result, types.NewTxError(result.TxResponse)
+			}
+			return result, nil
+		}
+		if status.Code(err) != codes.NotFound {
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, fmt.Errorf("tx %s: %w", hash, types.ErrTxTimeout)
+			}
+			return nil, err
+		}
+
+		if cfg.MaxBlocks > 0 {
+			height, err := client.latestHeight(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if height-startHeight >= cfg.MaxBlocks {
+				return nil, fmt.Errorf("tx %s not found after %d blocks: %w", hash, cfg.MaxBlocks, types.ErrTxTimeout)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, fmt.Errorf("tx %s: %w", hash, types.ErrTxTimeout)
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (client *TeleportClient) latestHeight(ctx context.Context) (int64, error) {
+	res, err := client.TMServiceQuery.GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Block.Header.Height, nil
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// fakeChain produces a block on every query of the latest block.
+type fakeChain struct {
+	tmservice.ServiceClient
+
+	height int64
+}
+
+func (c *fakeChain) GetLatestBlock(context.Context, *tmservice.GetLatestBlockRequest, ...grpc.CallOption) (*tmservice.GetLatestBlockResponse, error) {
+	c.height++
+	return &tmservice.GetLatestBlockResponse{Block: &tmproto.Block{Header: tmproto.Header{Height: c.height}}}, nil
+}
+
+func newWaitClient(t *testing.T, txClient *fakeTxClient, cfg WaitConfig) *TeleportClient {
+	c, _ := newOfflineClient(t)
+	c.TxClient = txClient
+	c.TMServiceQuery = &fakeChain{}
+	return c.WithWaitConfig(cfg)
+}
+
+func TestWaitForTx(t *testing.T) {
+	txClient := &fakeTxClient{pending: 2}
+	c := newWaitClient(t, txClient, WaitConfig{PollInterval: time.Millisecond, Timeout: time.Minute})
+
+	result, err := c.WaitForTx("ABCD")
+	require.NoError(t, err)
+	require.Equal(t, "ABCD", result.TxHash)
+	require.EqualValues(t, 10, result.Height)
+	require.Equal(t, 3, txClient.getTxs)
+}
+
+func TestWaitForTxFailed(t *testing.T) {
+	txClient := &fakeTxClient{code: 5}
+	c := newWaitClient(t, txClient, WaitConfig{PollInterval: time.Millisecond})
+
+	result, err := c.WaitForTx("ABCD")
+	require.NotNil(t, result)
+	var txErr *types.TxError
+	require.True(t, errors.As(err, &txErr))
+	require.EqualValues(t, 5, txErr.Code)
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+}
+
+func TestWaitForTxTimeout(t *testing.T) {
+	txClient := &fakeTxClient{pending: -1}
+	c := newWaitClient(t, txClient, WaitConfig{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond})
+
+	_, err := c.WaitForTx("ABCD")
+	require.ErrorIs(t, err, types.ErrTxTimeout)
+	require.Greater(t, txClient.getTxs, 1)
+
+	// a cancelled context is not a timeout
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.WaitForTxCtx(ctx, "ABCD")
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotErrorIs(t, err, types.ErrTxTimeout)
+}
+
+func TestWaitForTxMaxBlocks(t *testing.T) {
+	txClient := &fakeTxClient{pending: -1}
+	c := newWaitClient(t, txClient, WaitConfig{PollInterval: time.Millisecond, MaxBlocks: 3})
+
+	_, err := c.WaitForTx("ABCD")
+	require.ErrorIs(t, err, types.ErrTxTimeout)
+	require.Contains(t, err.Error(), "after 3 blocks")
+	// the chain produces a block on the start height query and after each missing tx
+	require.Equal(t, 3, txClient.getTxs)
+}
+
+func TestWaitForTxNodeError(t *testing.T) {
+	txClient := &fakeTxClient{getTxErr: status.Error(codes.Unavailable, "connection refused")}
+	c := newWaitClient(t, txClient, WaitConfig{PollInterval: time.Millisecond, Timeout: time.Minute})
+
+	_, err := c.WaitForTx("ABCD")
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, 1, txClient.getTxs)
+}
+
+func TestBroadcastAndWait(t *testing.T) {
+	txClient := &fakeTxClient{pending: 1}
+	c := newWaitClient(t, txClient, WaitConfig{PollInterval: time.Millisecond, Timeout: time.Minute})
+	c.AuthQuery = fakeAuthQuery{}
+	c.GetAccountRetriever().QueryClient = c.GClient
+	from, err := c.Key("acc1")
+	require.NoError(t, err)
+
+	msg := banktypes.MsgSend{FromAddress: from, ToAddress: from, Amount: sdk.NewCoins(sdk.NewInt64Coin("atele", 1))}
+	txf, err := Prepare(c, msg.GetSigners()[0], &msg, fixedFee)
+	require.NoError(t, err)
+	result, err := c.BroadcastAndWait(txf, &msg)
+	require.NoError(t, err)
+	require.Equal(t, "ABCD", result.TxHash)
+	require.Equal(t, 1, txClient.broadcasts)
+	require.Equal(t, 2, txClient.getTxs)
+}
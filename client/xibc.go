@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+
 	"github.com/cosmos/cosmos-sdk/types/tx"
 
 	clienttypes "github.com/teleport-network/teleport/x/xibc/core/client/types"
@@ -8,25 +10,37 @@ import (
 )
 
 func (client *TeleportClient) UpdateClient(msg clienttypes.MsgUpdateClient, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.UpdateClientCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) UpdateClientCtx(ctx context.Context, msg clienttypes.MsgUpdateClient, options ...Option) (*tx.BroadcastTxResponse, error) {
 	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
 	if err != nil {
 		return nil, err
 	}
-	return client.Broadcast(txf, &msg)
+	return client.BroadcastCtx(ctx, txf, &msg)
 }
 
 func (client *TeleportClient) RecvPacket(msg packettypes.MsgRecvPacket, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.RecvPacketCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) RecvPacketCtx(ctx context.Context, msg packettypes.MsgRecvPacket, options ...Option) (*tx.BroadcastTxResponse, error) {
 	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
 	if err != nil {
 		return nil, err
 	}
-	return client.Broadcast(txf, &msg)
+	return client.BroadcastCtx(ctx, txf, &msg)
 }
 
 func (client *TeleportClient) Acknowledgement(msg packettypes.MsgAcknowledgement, options ...Option) (*tx.BroadcastTxResponse, error) {
+	return client.AcknowledgementCtx(context.Background(), msg, options...)
+}
+
+func (client *TeleportClient) AcknowledgementCtx(ctx context.Context, msg packettypes.MsgAcknowledgement, options ...Option) (*tx.BroadcastTxResponse, error) {
 	txf, err := Prepare(client, msg.GetSigners()[0], &msg, options...)
 	if err != nil {
 		return nil, err
 	}
-	return client.Broadcast(txf, &msg)
+	return client.BroadcastCtx(ctx, txf, &msg)
 }
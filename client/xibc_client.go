@@ -0,0 +1,238 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	bsctypes "github.com/teleport-network/teleport/x/xibc/clients/light-clients/bsc/types"
+	ethclienttypes "github.com/teleport-network/teleport/x/xibc/clients/light-clients/eth/types"
+	tmclienttypes "github.com/teleport-network/teleport/x/xibc/clients/light-clients/tendermint/types"
+	clienttypes "github.com/teleport-network/teleport/x/xibc/core/client/types"
+	"github.com/teleport-network/teleport/x/xibc/exported"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/teleport-network/teleport-sdk-go/grpc"
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// ClientStatus is the state of the light client of a counterparty chain, as reported by ClientStatus.
+// The xibc light clients have no frozen height, so a client which can not be used is either Expired,
+// when its latest consensus state is older than the trusting period, or Unknown, when it is missing.
+type ClientStatus struct {
+	ChainName      string
+	ClientType     string
+	LatestHeight   exported.Height
+	TrustingPeriod time.Duration // zero for the clients which do not expire, like the tss client
+	Timestamp      time.Time     // of the consensus state at the latest height
+	Status         exported.Status
+}
+
+// IsActive reports whether the client can be used to relay packets.
+func (s ClientStatus) IsActive() bool {
+	return s.Status == exported.Active
+}
+
+// XIBCClientState queries the client state of the light client of chainName, unpacked into its concrete type,
+// e.g. *tmclienttypes.ClientState, *ethclienttypes.ClientState or *bsctypes.ClientState.
+func (client *TeleportClient) XIBCClientState(chainName string) (exported.ClientState, error) {
+	return client.XIBCClientStateCtx(context.Background(), chainName)
+}
+
+func (client *TeleportClient) XIBCClientStateCtx(ctx context.Context, chainName string) (exported.ClientState, error) {
+	res, err := client.XIBCClientQuery.ClientState(ctx, &clienttypes.QueryClientStateRequest{ChainName: chainName})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	var clientState exported.ClientState
+	if err := client.ctx.InterfaceRegistry.UnpackAny(res.ClientState, &clientState); err != nil {
+		return nil, err
+	}
+	return clientState, nil
+}
+
+// XIBCClientStates queries the client states of all the light clients, unpacked and keyed by chain name.
+func (client *TeleportClient) XIBCClientStates() (map[string]exported.ClientState, error) {
+	return client.XIBCClientStatesCtx(context.Background())
+}
+
+func (client *TeleportClient) XIBCClientStatesCtx(ctx context.Context) (map[string]exported.ClientState, error) {
+	res, err := client.XIBCClientQuery.ClientStates(ctx, &clienttypes.QueryClientStatesRequest{})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	clientStates := make(map[string]exported.ClientState, len(res.ClientStates))
+	for _, identified := range res.ClientStates {
+		var clientState exported.ClientState
+		if err := client.ctx.InterfaceRegistry.UnpackAny(identified.ClientState, &clientState); err != nil {
+			return nil, err
+		}
+		clientStates[identified.ChainName] = clientState
+	}
+	return clientStates, nil
+}
+
+// XIBCConsensusState queries the consensus state of the light client of chainName at height, unpacked into its
+// concrete type. A nil height queries the consensus state at the latest height of the client.
+func (client *TeleportClient) XIBCConsensusState(chainName string, height exported.Height) (exported.ConsensusState, error) {
+	return client.XIBCConsensusStateCtx(context.Background(), chainName, height)
+}
+
+func (client *TeleportClient) XIBCConsensusStateCtx(ctx context.Context, chainName string, height exported.Height) (exported.ConsensusState, error) {
+	req := &clienttypes.QueryConsensusStateRequest{ChainName: chainName, LatestHeight: height == nil}
+	if height != nil {
+		req.RevisionNumber = height.GetRevisionNumber()
+		req.RevisionHeight = height.GetRevisionHeight()
+	}
+	res, err := client.XIBCClientQuery.ConsensusState(ctx, req)
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	var consensusState exported.ConsensusState
+	if err := client.ctx.InterfaceRegistry.UnpackAny(res.ConsensusState, &consensusState); err != nil {
+		return nil, err
+	}
+	return consensusState, nil
+}
+
+// XIBCConsensusStates queries all the consensus states stored by the light client of chainName, through all pages.
+// The consensus states are unpacked.
+func (client *TeleportClient) XIBCConsensusStates(chainName string) ([]clienttypes.ConsensusStateWithHeight, error) {
+	return client.XIBCConsensusStatesCtx(context.Background(), chainName)
+}
+
+func (client *TeleportClient) XIBCConsensusStatesCtx(ctx context.Context, chainName string) ([]clienttypes.ConsensusStateWithHeight, error) {
+	var consensusStates []clienttypes.ConsensusStateWithHeight
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.XIBCClientQuery.ConsensusStates(ctx, &clienttypes.QueryConsensusStatesRequest{ChainName: chainName, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		consensusStates = append(consensusStates, res.ConsensusStates...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, consensusState := range consensusStates {
+		if err := consensusState.UnpackInterfaces(client.ctx.InterfaceRegistry); err != nil {
+			return nil, err
+		}
+	}
+	return consensusStates, nil
+}
+
+// ClientStatus queries the light client of chainName and reports its latest height, trusting period and status.
+// The expiry is checked against the time of the latest block, as the xibc module does.
+func (client *TeleportClient) ClientStatus(chainName string) (*ClientStatus, error) {
+	return client.ClientStatusCtx(context.Background(), chainName)
+}
+
+func (client *TeleportClient) ClientStatusCtx(ctx context.Context, chainName string) (*ClientStatus, error) {
+	clientState, err := client.XIBCClientStateCtx(ctx, chainName)
+	if err != nil {
+		return nil, err
+	}
+	now, err := client.latestBlockTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.clientStatus(ctx, chainName, clientState, now)
+}
+
+// InactiveClients returns the status of all the light clients which are expired or whose status is unknown.
+func (client *TeleportClient) InactiveClients() ([]ClientStatus, error) {
+	return client.InactiveClientsCtx(context.Background())
+}
+
+func (client *TeleportClient) InactiveClientsCtx(ctx context.Context) ([]ClientStatus, error) {
+	clientStates, err := client.XIBCClientStatesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now, err := client.latestBlockTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var inactive []ClientStatus
+	for chainName, clientState := range clientStates {
+		s, err := client.clientStatus(ctx, chainName, clientState, now)
+		if err != nil {
+			return nil, err
+		}
+		if !s.IsActive() {
+			inactive = append(inactive, *s)
+		}
+	}
+	return inactive, nil
+}
+
+// clientStatus reports the status of the light client of chainName at now. A missing consensus state makes
+// the status Unknown, while any other error querying it is returned.
+func (client *TeleportClient) clientStatus(ctx context.Context, chainName string, clientState exported.ClientState, now time.Time) (*ClientStatus, error) {
+	s := &ClientStatus{
+		ChainName:      chainName,
+		ClientType:     clientState.ClientType(),
+		LatestHeight:   clientState.GetLatestHeight(),
+		TrustingPeriod: trustingPeriod(clientState),
+		Status:         exported.Active,
+	}
+	if s.TrustingPeriod == 0 {
+		return s, nil
+	}
+	consensusState, err := client.XIBCConsensusStateCtx(ctx, chainName, s.LatestHeight)
+	if status.Code(err) == codes.NotFound {
+		s.Status = exported.Unknown
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.Timestamp = consensusTime(consensusState)
+	if expired(clientState, s.Timestamp.Add(s.TrustingPeriod), now) {
+		s.Status = exported.Expired
+	}
+	return s, nil
+}
+
+// trustingPeriod returns the trusting period of the light client, or zero if it does not expire.
+func trustingPeriod(clientState exported.ClientState) time.Duration {
+	switch cs := clientState.(type) {
+	case *tmclienttypes.ClientState:
+		return cs.TrustingPeriod
+	case *ethclienttypes.ClientState:
+		return time.Duration(cs.TrustingPeriod) * time.Second
+	case *bsctypes.ClientState:
+		return time.Duration(cs.TrustingPeriod) * time.Second
+	default:
+		return 0
+	}
+}
+
+// expired reports whether the light client, whose latest consensus state expires at expiry, is expired at now.
+// The tendermint client is expired from the expiry on, while the ethereum and bsc clients only after it,
+// compared in whole seconds as their consensus states are.
+func expired(clientState exported.ClientState, expiry, now time.Time) bool {
+	if _, ok := clientState.(*tmclienttypes.ClientState); ok {
+		return !expiry.After(now)
+	}
+	return expiry.Unix() < now.Unix()
+}
+
+// consensusTime returns the time of the consensus state. The ethereum and bsc clients store it in seconds.
+func consensusTime(consensusState exported.ConsensusState) time.Time {
+	if cs, ok := consensusState.(*tmclienttypes.ConsensusState); ok {
+		return cs.Timestamp
+	}
+	return time.Unix(int64(consensusState.GetTimestamp()), 0)
+}
+
+func (client *TeleportClient) latestBlockTime(ctx context.Context) (time.Time, error) {
+	res, err := client.TMServiceQuery.GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	if err != nil {
+		return time.Time{}, types.WrapNodeError(err)
+	}
+	return res.Block.Header.Time, nil
+}
@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	bsctypes "github.com/teleport-network/teleport/x/xibc/clients/light-clients/bsc/types"
+	ethclienttypes "github.com/teleport-network/teleport/x/xibc/clients/light-clients/eth/types"
+	tmclienttypes "github.com/teleport-network/teleport/x/xibc/clients/light-clients/tendermint/types"
+	clienttypes "github.com/teleport-network/teleport/x/xibc/core/client/types"
+	"github.com/teleport-network/teleport/x/xibc/exported"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+var blockTime = time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeClientQuery serves a tendermint client updated an hour ago with a day of trusting period,
+// a bsc client updated two days ago with a day of trusting period, and an eth client without consensus state.
+// The tendermint and bsc "-edge" clients were updated exactly a trusting period ago.
+type fakeClientQuery struct {
+	clienttypes.QueryClient
+}
+
+func (fakeClientQuery) clientStates() map[string]proto.Message {
+	return map[string]proto.Message{
+		"teleport":      &tmclienttypes.ClientState{ChainId: "teleport_8001-1", TrustingPeriod: 24 * time.Hour, LatestHeight: clienttypes.NewHeight(1, 100)},
+		"teleport-edge": &tmclienttypes.ClientState{ChainId: "teleport_8001-2", TrustingPeriod: 24 * time.Hour, LatestHeight: clienttypes.NewHeight(2, 100)},
+		"bsc":           &bsctypes.ClientState{Header: bsctypes.Header{Height: clienttypes.NewHeight(0, 200)}, TrustingPeriod: 86400},
+		"bsc-edge":      &bsctypes.ClientState{Header: bsctypes.Header{Height: clienttypes.NewHeight(0, 200)}, TrustingPeriod: 86400},
+		"eth":           &ethclienttypes.ClientState{Header: ethclienttypes.Header{Height: clienttypes.NewHeight(0, 300)}, TrustingPeriod: 86400},
+	}
+}
+
+func (q fakeClientQuery) ClientState(_ context.Context, req *clienttypes.QueryClientStateRequest, _ ...grpc.CallOption) (*clienttypes.QueryClientStateResponse, error) {
+	clientState, ok := q.clientStates()[req.ChainName]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "client not found")
+	}
+	any, err := codectypes.NewAnyWithValue(clientState)
+	return &clienttypes.QueryClientStateResponse{ClientState: any}, err
+}
+
+func (q fakeClientQuery) ClientStates(context.Context, *clienttypes.QueryClientStatesRequest, ...grpc.CallOption) (*clienttypes.QueryClientStatesResponse, error) {
+	res := &clienttypes.QueryClientStatesResponse{}
+	for chainName, clientState := range q.clientStates() {
+		any, err := codectypes.NewAnyWithValue(clientState)
+		if err != nil {
+			return nil, err
+		}
+		res.ClientStates = append(res.ClientStates, clienttypes.IdentifiedClientState{ChainName: chainName, ClientState: any})
+	}
+	return res, nil
+}
+
+func (fakeClientQuery) ConsensusState(_ context.Context, req *clienttypes.QueryConsensusStateRequest, _ ...grpc.CallOption) (*clienttypes.QueryConsensusStateResponse, error) {
+	var consensusState proto.Message
+	switch req.ChainName {
+	case "teleport":
+		consensusState = &tmclienttypes.ConsensusState{Timestamp: blockTime.Add(-time.Hour)}
+	case "teleport-edge":
+		consensusState = &tmclienttypes.ConsensusState{Timestamp: blockTime.Add(-24 * time.Hour)}
+	case "bsc":
+		consensusState = &bsctypes.ConsensusState{Timestamp: uint64(blockTime.Add(-48 * time.Hour).Unix())}
+	case "bsc-edge":
+		consensusState = &bsctypes.ConsensusState{Timestamp: uint64(blockTime.Add(-24 * time.Hour).Unix())}
+	default:
+		return nil, status.Error(codes.NotFound, "consensus state not found")
+	}
+	any, err := codectypes.NewAnyWithValue(consensusState)
+	return &clienttypes.QueryConsensusStateResponse{ConsensusState: any}, err
+}
+
+// unavailableClientQuery fails to serve consensus states.
+type unavailableClientQuery struct {
+	fakeClientQuery
+}
+
+func (unavailableClientQuery) ConsensusState(context.Context, *clienttypes.QueryConsensusStateRequest, ...grpc.CallOption) (*clienttypes.QueryConsensusStateResponse, error) {
+	return nil, status.Error(codes.Unavailable, "connection refused")
+}
+
+type fakeTMService struct {
+	tmservice.ServiceClient
+}
+
+func (fakeTMService) GetLatestBlock(context.Context, *tmservice.GetLatestBlockRequest, ...grpc.CallOption) (*tmservice.GetLatestBlockResponse, error) {
+	return &tmservice.GetLatestBlockResponse{Block: &tmproto.Block{Header: tmproto.Header{Time: blockTime}}}, nil
+}
+
+func TestClientStatus(t *testing.T) {
+	c, _ := newOfflineClient(t)
+	c.XIBCClientQuery = fakeClientQuery{}
+	c.TMServiceQuery = fakeTMService{}
+
+	clientState, err := c.XIBCClientState("teleport")
+	require.NoError(t, err)
+	require.IsType(t, &tmclienttypes.ClientState{}, clientState)
+
+	teleport, err := c.ClientStatus("teleport")
+	require.NoError(t, err)
+	require.Equal(t, exported.Active, teleport.Status)
+	require.Equal(t, exported.Tendermint, teleport.ClientType)
+	require.Equal(t, uint64(100), teleport.LatestHeight.GetRevisionHeight())
+	require.Equal(t, 24*time.Hour, teleport.TrustingPeriod)
+	require.True(t, teleport.Timestamp.Equal(blockTime.Add(-time.Hour)))
+
+	bsc, err := c.ClientStatus("bsc")
+	require.NoError(t, err)
+	require.Equal(t, exported.Expired, bsc.Status)
+	require.Equal(t, 24*time.Hour, bsc.TrustingPeriod)
+
+	// at the end of the trusting period the tendermint client is expired, the bsc client not yet
+	teleportEdge, err := c.ClientStatus("teleport-edge")
+	require.NoError(t, err)
+	require.Equal(t, exported.Expired, teleportEdge.Status)
+	bscEdge, err := c.ClientStatus("bsc-edge")
+	require.NoError(t, err)
+	require.Equal(t, exported.Active, bscEdge.Status)
+
+	inactive, err := c.InactiveClients()
+	require.NoError(t, err)
+	statuses := make(map[string]exported.Status)
+	for _, s := range inactive {
+		statuses[s.ChainName] = s.Status
+	}
+	require.Equal(t, map[string]exported.Status{"teleport-edge": exported.Expired, "bsc": exported.Expired, "eth": exported.Unknown}, statuses)
+
+	_, err = c.ClientStatus("unknown")
+	require.Error(t, err)
+
+	// only a missing consensus state makes the status unknown
+	c.XIBCClientQuery = unavailableClientQuery{}
+	_, err = c.ClientStatus("bsc")
+	require.ErrorIs(t, err, types.ErrUnavailable)
+	_, err = c.InactiveClients()
+	require.ErrorIs(t, err, types.ErrUnavailable)
+}
+
+func TestExpired(t *testing.T) {
+	expiry := blockTime
+	tm := &tmclienttypes.ClientState{}
+	bsc := &bsctypes.ClientState{}
+
+	require.True(t, expired(tm, expiry, expiry))
+	require.False(t, expired(bsc, expiry, expiry))
+
+	// the ethereum and bsc clients compare whole seconds, like the chain
+	require.True(t, expired(tm, expiry, expiry.Add(500*time.Millisecond)))
+	require.False(t, expired(bsc, expiry, expiry.Add(500*time.Millisecond)))
+	require.True(t, expired(bsc, expiry, expiry.Add(time.Second)))
+}
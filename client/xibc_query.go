@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/teleport-network/teleport/x/xibc/core/host"
+	packettypes "github.com/teleport-network/teleport/x/xibc/core/packet/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/teleport-network/teleport-sdk-go/grpc"
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// PacketCommitment queries the commitment of the packet with sequence sent from sourceChain to destChain,
+// with its proof. The commitment is empty once the packet has been acknowledged.
+func (client *TeleportClient) PacketCommitment(sourceChain, destChain string, sequence uint64) (*packettypes.QueryPacketCommitmentResponse, error) {
+	return client.PacketCommitmentCtx(context.Background(), sourceChain, destChain, sequence)
+}
+
+func (client *TeleportClient) PacketCommitmentCtx(ctx context.Context, sourceChain, destChain string, sequence uint64) (*packettypes.QueryPacketCommitmentResponse, error) {
+	res, err := client.XIBCPacketQuery.PacketCommitment(ctx, &packettypes.QueryPacketCommitmentRequest{SourceChain: sourceChain, DestChain: destChain, Sequence: sequence})
+	return res, types.WrapNodeError(err)
+}
+
+// PacketCommitments queries the commitments of all the packets sent from sourceChain to destChain and not
+// acknowledged yet, through all pages.
+func (client *TeleportClient) PacketCommitments(sourceChain, destChain string) ([]*packettypes.PacketState, error) {
+	return client.PacketCommitmentsCtx(context.Background(), sourceChain, destChain)
+}
+
+func (client *TeleportClient) PacketCommitmentsCtx(ctx context.Context, sourceChain, destChain string) ([]*packettypes.PacketState, error) {
+	var commitments []*packettypes.PacketState
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.XIBCPacketQuery.PacketCommitments(ctx, &packettypes.QueryPacketCommitmentsRequest{SourceChain: sourceChain, DestChain: destChain, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		commitments = append(commitments, res.Commitments...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return commitments, nil
+}
+
+// PacketReceipt queries whether the packet with sequence sent from sourceChain to destChain has been received.
+func (client *TeleportClient) PacketReceipt(sourceChain, destChain string, sequence uint64) (bool, error) {
+	return client.PacketReceiptCtx(context.Background(), sourceChain, destChain, sequence)
+}
+
+func (client *TeleportClient) PacketReceiptCtx(ctx context.Context, sourceChain, destChain string, sequence uint64) (bool, error) {
+	res, err := client.XIBCPacketQuery.PacketReceipt(ctx, &packettypes.QueryPacketReceiptRequest{SourceChain: sourceChain, DestChain: destChain, Sequence: sequence})
+	if err != nil {
+		return false, types.WrapNodeError(err)
+	}
+	return res.Received, nil
+}
+
+// PacketAcknowledgement queries the acknowledgement written for the packet with sequence sent from sourceChain
+// to destChain, with its proof.
+func (client *TeleportClient) PacketAcknowledgement(sourceChain, destChain string, sequence uint64) (*packettypes.QueryPacketAcknowledgementResponse, error) {
+	return client.PacketAcknowledgementCtx(context.Background(), sourceChain, destChain, sequence)
+}
+
+func (client *TeleportClient) PacketAcknowledgementCtx(ctx context.Context, sourceChain, destChain string, sequence uint64) (*packettypes.QueryPacketAcknowledgementResponse, error) {
+	res, err := client.XIBCPacketQuery.PacketAcknowledgement(ctx, &packettypes.QueryPacketAcknowledgementRequest{SourceChain: sourceChain, DestChain: destChain, Sequence: sequence})
+	return res, types.WrapNodeError(err)
+}
+
+// PacketAcknowledgements queries the acknowledgements of all the packets received from sourceChain, through all pages.
+func (client *TeleportClient) PacketAcknowledgements(sourceChain, destChain string) ([]*packettypes.PacketState, error) {
+	return client.PacketAcknowledgementsCtx(context.Background(), sourceChain, destChain)
+}
+
+func (client *TeleportClient) PacketAcknowledgementsCtx(ctx context.Context, sourceChain, destChain string) ([]*packettypes.PacketState, error) {
+	var acks []*packettypes.PacketState
+	err := grpc.Paginate(ctx, nil, func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := client.XIBCPacketQuery.PacketAcknowledgements(ctx, &packettypes.QueryPacketAcknowledgementsRequest{SourceChain: sourceChain, DestChain: destChain, Pagination: pageReq})
+		if err != nil {
+			return nil, types.WrapNodeError(err)
+		}
+		acks = append(acks, res.Acknowledgements...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return acks, nil
+}
+
+// UnreceivedPackets returns the sequences, out of the given commitment sequences of the packets sent from
+// sourceChain, of the packets not received yet. It is queried on the destination chain.
+func (client *TeleportClient) UnreceivedPackets(sourceChain, destChain string, sequences []uint64) ([]uint64, error) {
+	return client.UnreceivedPacketsCtx(context.Background(), sourceChain, destChain, sequences)
+}
+
+func (client *TeleportClient) UnreceivedPacketsCtx(ctx context.Context, sourceChain, destChain string, sequences []uint64) ([]uint64, error) {
+	res, err := client.XIBCPacketQuery.UnreceivedPackets(ctx, &packettypes.QueryUnreceivedPacketsRequest{SourceChain: sourceChain, DestChain: destChain, PacketCommitmentSequences: sequences})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	return res.Sequences, nil
+}
+
+// UnreceivedAcks returns the sequences, out of the given acknowledgement sequences of the packets received on
+// destChain, of the packets whose acknowledgement has not been received yet. It is queried on the source chain.
+func (client *TeleportClient) UnreceivedAcks(sourceChain, destChain string, sequences []uint64) ([]uint64, error) {
+	return client.UnreceivedAcksCtx(context.Background(), sourceChain, destChain, sequences)
+}
+
+func (client *TeleportClient) UnreceivedAcksCtx(ctx context.Context, sourceChain, destChain string, sequences []uint64) ([]uint64, error) {
+	res, err := client.XIBCPacketQuery.UnreceivedAcks(ctx, &packettypes.QueryUnreceivedAcksRequest{SourceChain: sourceChain, DestChain: destChain, PacketAckSequences: sequences})
+	if err != nil {
+		return nil, types.WrapNodeError(err)
+	}
+	return res.Sequences, nil
+}
+
+// NextSequenceSend queries the sequence of the next packet sent from sourceChain to destChain.
+// The xibc module has no gRPC query for it, so it is read from the store with an ABCI query.
+func (client *TeleportClient) NextSequenceSend(sourceChain, destChain string) (uint64, error) {
+	return client.NextSequenceSendCtx(context.Background(), sourceChain, destChain)
+}
+
+func (client *TeleportClient) NextSequenceSendCtx(ctx context.Context, sourceChain, destChain string) (uint64, error) {
+	res, err := client.ABCIQuery.Query(ctx, &abci.RequestQuery{
+		Path: fmt.Sprintf("store/%s/key", host.StoreKey),
+		Data: host.NextSequenceSendKey(sourceChain, destChain),
+	})
+	if err != nil {
+		return 0, types.WrapNodeError(err)
+	}
+	if res.Code != 0 {
+		return 0, fmt.Errorf("query next sequence send failed: %s", res.Log)
+	}
+	if len(res.Value) == 0 {
+		return 1, nil
+	}
+	return sdk.BigEndianToUint64(res.Value), nil
+}
+
+// PendingPackets returns the sequences of the packets sent from sourceChain to destChain which are committed on
+// this chain, the source chain, but not received by dest, a client of the destination chain.
+func (client *TeleportClient) PendingPackets(dest *TeleportClient, sourceChain, destChain string) ([]uint64, error) {
+	return client.PendingPacketsCtx(context.Background(), dest, sourceChain, destChain)
+}
+
+func (client *TeleportClient) PendingPacketsCtx(ctx context.Context, dest *TeleportClient, sourceChain, destChain string) ([]uint64, error) {
+	commitments, err := client.PacketCommitmentsCtx(ctx, sourceChain, destChain)
+	if err != nil {
+		return nil, err
+	}
+	if len(commitments) == 0 {
+		return nil, nil
+	}
+	sequences := make([]uint64, len(commitments))
+	for i, commitment := range commitments {
+		sequences[i] = commitment.Sequence
+	}
+	return dest.UnreceivedPacketsCtx(ctx, sourceChain, destChain, sequences)
+}
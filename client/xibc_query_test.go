@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"github.com/teleport-network/teleport/x/xibc/core/host"
+	packettypes "github.com/teleport-network/teleport/x/xibc/core/packet/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+)
+
+// fakePacketQuery serves the packet commitments of sequences 1 to 5 in pages of two,
+// and reports the odd sequences as unreceived.
+type fakePacketQuery struct {
+	packettypes.QueryClient
+}
+
+func (fakePacketQuery) PacketCommitments(_ context.Context, req *packettypes.QueryPacketCommitmentsRequest, _ ...grpc.CallOption) (*packettypes.QueryPacketCommitmentsResponse, error) {
+	start := uint64(1)
+	if len(req.Pagination.GetKey()) > 0 {
+		start = sdk.BigEndianToUint64(req.Pagination.Key)
+	}
+	res := &packettypes.QueryPacketCommitmentsResponse{Pagination: &query.PageResponse{}}
+	for seq := start; seq < start+2 && seq <= 5; seq++ {
+		res.Commitments = append(res.Commitments, &packettypes.PacketState{SourceChain: req.SourceChain, DestinationChain: req.DestChain, Sequence: seq})
+	}
+	if start+2 <= 5 {
+		res.Pagination.NextKey = sdk.Uint64ToBigEndian(start + 2)
+	}
+	return res, nil
+}
+
+func (fakePacketQuery) UnreceivedPackets(_ context.Context, req *packettypes.QueryUnreceivedPacketsRequest, _ ...grpc.CallOption) (*packettypes.QueryUnreceivedPacketsResponse, error) {
+	res := &packettypes.QueryUnreceivedPacketsResponse{}
+	for _, seq := range req.PacketCommitmentSequences {
+		if seq%2 == 1 {
+			res.Sequences = append(res.Sequences, seq)
+		}
+	}
+	return res, nil
+}
+
+type fakeABCIQuery struct {
+	store map[string][]byte
+}
+
+func (fakeABCIQuery) Info(context.Context, *abci.RequestInfo, ...grpc.CallOption) (*abci.ResponseInfo, error) {
+	return &abci.ResponseInfo{}, nil
+}
+
+func (q fakeABCIQuery) Query(_ context.Context, req *abci.RequestQuery, _ ...grpc.CallOption) (*abci.ResponseQuery, error) {
+	if req.Path != "store/xibc/key" {
+		return &abci.ResponseQuery{Code: 1, Log: "unknown path " + req.Path}, nil
+	}
+	return &abci.ResponseQuery{Value: q.store[string(req.Data)]}, nil
+}
+
+func TestPendingPackets(t *testing.T) {
+	c, _ := newOfflineClient(t)
+	c.XIBCPacketQuery = fakePacketQuery{}
+
+	commitments, err := c.PacketCommitments("teleport", "bsc")
+	require.NoError(t, err)
+	require.Len(t, commitments, 5)
+
+	pending, err := c.PendingPackets(c, "teleport", "bsc")
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3, 5}, pending)
+}
+
+func TestNextSequenceSend(t *testing.T) {
+	c, _ := newOfflineClient(t)
+	c.ABCIQuery = fakeABCIQuery{store: map[string][]byte{
+		string(host.NextSequenceSendKey("teleport", "bsc")): sdk.Uint64ToBigEndian(7),
+	}}
+
+	seq, err := c.NextSequenceSend("teleport", "bsc")
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), seq)
+
+	// no packet sent yet
+	seq, err = c.NextSequenceSend("teleport", "eth")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), seq)
+}
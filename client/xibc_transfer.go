@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	transfer "github.com/teleport-network/teleport/syscontracts/xibc_transfer"
+	transfertypes "github.com/teleport-network/teleport/x/xibc/apps/transfer/types"
+	packettypes "github.com/teleport-network/teleport/x/xibc/core/packet/types"
+)
+
+// XIBCTransfer is a transfer of the xibc transfer app to a receiver on DestChain. The transfer app of Teleport is
+// the transfer system contract, so the transfer is sent in an ethereum tx calling it. The tokens and the relay fee
+// are either the native tele, given by the zero address, or ERC20 tokens, which must have been approved to the
+// transfer contract for the amount and the fee.
+type XIBCTransfer struct {
+	Token      common.Address // zero address for the native tele
+	Receiver   string         // address on the destination chain
+	Amount     *big.Int
+	DestChain  string
+	RelayChain string // empty for a transfer sent directly to DestChain
+	FeeToken   common.Address
+	Fee        *big.Int // relay fee, nil for none
+	// Timeout bounds the time to send the transfer and wait for it to be committed. The xibc packets have no
+	// timeout of their own, so a packet committed on this chain stays pending until it is relayed.
+	Timeout time.Duration
+}
+
+// XIBCTransferResponse is the response of a committed transfer, with the sequence of its packet.
+type XIBCTransferResponse struct {
+	*EthTxResponse
+	Result   *TxResult
+	Sequence uint64
+}
+
+// CrossChainTransfer sends the transfer, signed by from with the fields of opts, see SendEthTx, waits for the tx
+// to be committed and returns the sequence of the packet sent from this chain, which can be tracked with
+// PacketReceipt on the destination chain.
+func (client *TeleportClient) CrossChainTransfer(from string, xfer XIBCTransfer, opts EthTx) (*XIBCTransferResponse, error) {
+	return client.CrossChainTransferCtx(context.Background(), from, xfer, opts)
+}
+
+func (client *TeleportClient) CrossChainTransferCtx(ctx context.Context, from string, xfer XIBCTransfer, opts EthTx) (*XIBCTransferResponse, error) {
+	if xfer.Amount == nil || xfer.Amount.Sign() <= 0 {
+		return nil, errors.New("transfer amount must be positive")
+	}
+	if len(xfer.Receiver) == 0 {
+		return nil, errors.New("receiver can not be empty")
+	}
+	if len(xfer.DestChain) == 0 {
+		return nil, errors.New("destination chain can not be empty")
+	}
+	fee := xfer.Fee
+	if fee == nil {
+		fee = new(big.Int)
+	}
+	if xfer.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, xfer.Timeout)
+		defer cancel()
+	}
+
+	// the native tele of the transfer and of the fee is paid as the value of the tx
+	value := new(big.Int)
+	if xfer.Token == (common.Address{}) {
+		value.Add(value, xfer.Amount)
+	}
+	if xfer.FeeToken == (common.Address{}) {
+		value.Add(value, fee)
+	}
+	opts.Value = value
+
+	contract := client.BindContract(transfer.TransferContractAddress, transfer.TransferContract.ABI)
+	res, err := contract.TransactCtx(ctx, from, opts, "sendTransfer",
+		transfertypes.TransferData{
+			TokenAddress: xfer.Token,
+			Receiver:     xfer.Receiver,
+			Amount:       xfer.Amount,
+			DestChain:    xfer.DestChain,
+			RelayChain:   xfer.RelayChain,
+		},
+		transfertypes.Fee{TokenAddress: xfer.FeeToken, Amount: fee},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.WaitForTxCtx(ctx, res.TxResponse.TxHash)
+	if err != nil {
+		return &XIBCTransferResponse{EthTxResponse: res, Result: result}, err
+	}
+	sequence, err := sendPacketSequence(result)
+	return &XIBCTransferResponse{EthTxResponse: res, Result: result, Sequence: sequence}, err
+}
+
+// sendPacketSequence returns the sequence of the packet sent by the committed tx of result.
+func sendPacketSequence(result *TxResult) (uint64, error) {
+	values := result.Attributes(proto.MessageName(&packettypes.EventSendPacket{}), "sequence")
+	if len(values) == 0 {
+		return 0, fmt.Errorf("no packet sent by tx %s", result.TxHash)
+	}
+	// the attributes of typed events are JSON values
+	var sequence string
+	if err := json.Unmarshal([]byte(values[0]), &sequence); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(sequence, 10, 64)
+}
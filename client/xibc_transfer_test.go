@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	transfer "github.com/teleport-network/teleport/syscontracts/xibc_transfer"
+	packettypes "github.com/teleport-network/teleport/x/xibc/core/packet/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/teleport-network/teleport-sdk-go/types"
+)
+
+// fakeEVMQuery records the context and the call of the gas estimation, and fails it.
+type fakeEVMQuery struct {
+	evmtypes.QueryClient
+
+	ctx  context.Context
+	args evmtypes.TransactionArgs
+}
+
+func (q *fakeEVMQuery) EstimateGas(ctx context.Context, req *evmtypes.EthCallRequest, _ ...grpc.CallOption) (*evmtypes.EstimateGasResponse, error) {
+	q.ctx = ctx
+	if err := json.Unmarshal(req.Args, &q.args); err != nil {
+		return nil, err
+	}
+	return nil, status.Error(codes.Unavailable, "connection refused")
+}
+
+func TestCrossChainTransferValue(t *testing.T) {
+	c, from := newOfflineClient(t)
+	erc20 := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	testCases := []struct {
+		name     string
+		token    common.Address
+		feeToken common.Address
+		fee      *big.Int
+		value    int64
+	}{
+		{"native amount and fee", common.Address{}, common.Address{}, big.NewInt(7), 107},
+		{"native amount without fee", common.Address{}, common.Address{}, nil, 100},
+		{"erc20 amount and native fee", erc20, common.Address{}, big.NewInt(7), 7},
+		{"native amount and erc20 fee", common.Address{}, erc20, big.NewInt(7), 100},
+		{"erc20 amount and fee", erc20, erc20, big.NewInt(7), 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evmQuery := &fakeEVMQuery{}
+			c.EVMQuery = evmQuery
+			xfer := XIBCTransfer{Token: tc.token, Receiver: "0xreceiver", Amount: big.NewInt(100), DestChain: "bsc", FeeToken: tc.feeToken, Fee: tc.fee}
+
+			_, err := c.CrossChainTransfer(from.String(), xfer, EthTx{})
+			require.ErrorIs(t, err, types.ErrUnavailable)
+			require.Equal(t, transfer.TransferContractAddress, *evmQuery.args.To)
+			require.Equal(t, tc.value, evmQuery.args.Value.ToInt().Int64())
+
+			args, err := transfer.TransferContract.ABI.Methods["sendTransfer"].Inputs.Unpack((*evmQuery.args.Data)[4:])
+			require.NoError(t, err)
+			require.Len(t, args, 2)
+		})
+	}
+}
+
+func TestCrossChainTransferValidation(t *testing.T) {
+	c, from := newOfflineClient(t)
+	evmQuery := &fakeEVMQuery{}
+	c.EVMQuery = evmQuery
+	valid := XIBCTransfer{Receiver: "0xreceiver", Amount: big.NewInt(100), DestChain: "bsc"}
+
+	testCases := []struct {
+		name   string
+		modify func(*XIBCTransfer)
+		err    string
+	}{
+		{"nil amount", func(xfer *XIBCTransfer) { xfer.Amount = nil }, "transfer amount must be positive"},
+		{"zero amount", func(xfer *XIBCTransfer) { xfer.Amount = big.NewInt(0) }, "transfer amount must be positive"},
+		{"negative amount", func(xfer *XIBCTransfer) { xfer.Amount = big.NewInt(-1) }, "transfer amount must be positive"},
+		{"empty receiver", func(xfer *XIBCTransfer) { xfer.Receiver = "" }, "receiver can not be empty"},
+		{"empty destination chain", func(xfer *XIBCTransfer) { xfer.DestChain = "" }, "destination chain can not be empty"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			xfer := valid
+			tc.modify(&xfer)
+			_, err := c.CrossChainTransfer(from.String(), xfer, EthTx{})
+			require.EqualError(t, err, tc.err)
+		})
+	}
+	require.Nil(t, evmQuery.ctx)
+}
+
+func TestCrossChainTransferTimeout(t *testing.T) {
+	c, from := newOfflineClient(t)
+	evmQuery := &fakeEVMQuery{}
+	c.EVMQuery = evmQuery
+	xfer := XIBCTransfer{Receiver: "0xreceiver", Amount: big.NewInt(100), DestChain: "bsc"}
+
+	_, err := c.CrossChainTransfer(from.String(), xfer, EthTx{})
+	require.Error(t, err)
+	_, ok := evmQuery.ctx.Deadline()
+	require.False(t, ok)
+
+	xfer.Timeout = time.Minute
+	start := time.Now()
+	_, err = c.CrossChainTransfer(from.String(), xfer, EthTx{})
+	require.Error(t, err)
+	deadline, ok := evmQuery.ctx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, start.Add(time.Minute), deadline, 5*time.Second)
+	// the timeout context is released once the transfer returns
+	require.ErrorIs(t, evmQuery.ctx.Err(), context.Canceled)
+}
+
+func TestSendPacketSequence(t *testing.T) {
+	event, err := sdk.TypedEventToEvent(&packettypes.EventSendPacket{Sequence: "12", SrcChain: "teleport", DstChain: "bsc"})
+	require.NoError(t, err)
+	result := &TxResult{TxResponse: &sdk.TxResponse{Logs: sdk.ABCIMessageLogs{{
+		Events: sdk.StringifyEvents([]abci.Event{abci.Event(event)}),
+	}}}}
+
+	sequence, err := sendPacketSequence(result)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), sequence)
+
+	_, err = sendPacketSequence(&TxResult{TxResponse: &sdk.TxResponse{TxHash: "ABCD"}})
+	require.Error(t, err)
+}
@@ -6,12 +6,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	abcitypes "github.com/teleport-network/teleport/grpc_abci/types"
+	aggregatetypes "github.com/teleport-network/teleport/x/aggregate/types"
 	xibcclitypes "github.com/teleport-network/teleport/x/xibc/core/client/types"
 	xibcpkttypes "github.com/teleport-network/teleport/x/xibc/core/packet/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
 
 	grpc1 "github.com/gogo/protobuf/grpc"
 	"google.golang.org/grpc"
@@ -19,16 +25,22 @@ import (
 )
 
 type GClient struct {
-	clientConn      grpc1.ClientConn
-	AuthQuery       authtypes.QueryClient
-	BankQuery       banktypes.QueryClient
-	GovQuery        govtypes.QueryClient
-	StakingQuery    stakingtypes.QueryClient
-	XIBCClientQuery xibcclitypes.QueryClient
-	XIBCPacketQuery xibcpkttypes.QueryClient
-	ABCIQuery       abcitypes.ABCIQueryClient
-	TMServiceQuery  tmservice.ServiceClient
-	TxClient        tx.ServiceClient
+	clientConn        grpc1.ClientConn
+	AuthQuery         authtypes.QueryClient
+	BankQuery         banktypes.QueryClient
+	GovQuery          govtypes.QueryClient
+	StakingQuery      stakingtypes.QueryClient
+	DistributionQuery distrtypes.QueryClient
+	AuthzQuery        authz.QueryClient
+	FeeGrantQuery     feegrant.QueryClient
+	FeeMarketQuery    feemarkettypes.QueryClient
+	EVMQuery          evmtypes.QueryClient
+	ERC20Query        aggregatetypes.QueryClient // token pairs of the aggregate module, the erc20 module of teleport
+	XIBCClientQuery   xibcclitypes.QueryClient
+	XIBCPacketQuery   xibcpkttypes.QueryClient
+	ABCIQuery         abcitypes.ABCIQueryClient
+	TMServiceQuery    tmservice.ServiceClient
+	TxClient          tx.ServiceClient
 }
 
 func NewGRPCClient(url string) (GClient, error) {
@@ -58,14 +70,21 @@ func buildGRPCClient(url string, opts ...grpc.DialOption) (GClient, error) {
 		return GClient{}, err
 	}
 	return GClient{
-		clientConn:      clientConn,
-		XIBCClientQuery: xibcclitypes.NewQueryClient(clientConn),
-		XIBCPacketQuery: xibcpkttypes.NewQueryClient(clientConn),
-		ABCIQuery:       abcitypes.NewABCIQueryClient(clientConn),
-		BankQuery:       banktypes.NewQueryClient(clientConn),
-		AuthQuery:       authtypes.NewQueryClient(clientConn),
-		StakingQuery:    stakingtypes.NewQueryClient(clientConn),
-		TMServiceQuery:  tmservice.NewServiceClient(clientConn),
-		TxClient:        tx.NewServiceClient(clientConn),
+		clientConn:        clientConn,
+		XIBCClientQuery:   xibcclitypes.NewQueryClient(clientConn),
+		XIBCPacketQuery:   xibcpkttypes.NewQueryClient(clientConn),
+		ABCIQuery:         abcitypes.NewABCIQueryClient(clientConn),
+		BankQuery:         banktypes.NewQueryClient(clientConn),
+		GovQuery:          govtypes.NewQueryClient(clientConn),
+		AuthQuery:         authtypes.NewQueryClient(clientConn),
+		StakingQuery:      stakingtypes.NewQueryClient(clientConn),
+		DistributionQuery: distrtypes.NewQueryClient(clientConn),
+		AuthzQuery:        authz.NewQueryClient(clientConn),
+		FeeGrantQuery:     feegrant.NewQueryClient(clientConn),
+		FeeMarketQuery:    feemarkettypes.NewQueryClient(clientConn),
+		EVMQuery:          evmtypes.NewQueryClient(clientConn),
+		ERC20Query:        aggregatetypes.NewQueryClient(clientConn),
+		TMServiceQuery:    tmservice.NewServiceClient(clientConn),
+		TxClient:          tx.NewServiceClient(clientConn),
 	}, nil
 }
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -11,6 +12,17 @@ import (
 
 const GrpcUrl = "grpc0.testnet.teleport.network:443"
 
+func TestBuildGRPCClient(t *testing.T) {
+	// dialing does not wait for the connection
+	c, err := NewGRPCClient("localhost:9090")
+	require.NoError(t, err)
+
+	v := reflect.ValueOf(c)
+	for i := 0; i < v.NumField(); i++ {
+		require.False(t, v.Field(i).IsNil(), "%s is not set", v.Type().Field(i).Name)
+	}
+}
+
 func TestQueryBalance(t *testing.T) {
 	c, err := NewGRPCClientWithTLSDefault(GrpcUrl)
 	require.NoError(t, err)
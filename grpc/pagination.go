@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// PageFunc queries a single page with the given page request and returns the pagination of the response.
+type PageFunc func(ctx context.Context, pageReq *query.PageRequest) (*query.PageResponse, error)
+
+// Paginate calls fn page after page, following the next key of each response until the last page.
+// The first page is queried with pageReq, which may be nil to start from the first item with the
+// default page size of the node. The following pages are queried by key with the same limit.
+func Paginate(ctx context.Context, pageReq *query.PageRequest, fn PageFunc) error {
+	req := query.PageRequest{}
+	if pageReq != nil {
+		req = *pageReq
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		res, err := fn(ctx, &req)
+		if err != nil {
+			return err
+		}
+		if res == nil || len(res.NextKey) == 0 {
+			return nil
+		}
+		req = query.PageRequest{Key: res.NextKey, Limit: req.Limit, Reverse: req.Reverse}
+	}
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginate(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+	// page serves items by key, the key being the index of the first item of the page
+	page := func(req *query.PageRequest) ([]string, *query.PageResponse) {
+		start := req.Offset
+		if len(req.Key) > 0 {
+			start = uint64(req.Key[0])
+		}
+		end := start + req.Limit
+		if end >= uint64(len(items)) {
+			return items[start:], &query.PageResponse{}
+		}
+		return items[start:end], &query.PageResponse{NextKey: []byte{byte(end)}}
+	}
+
+	var got []string
+	var reqs []query.PageRequest
+	err := Paginate(context.Background(), &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true}, func(ctx context.Context, req *query.PageRequest) (*query.PageResponse, error) {
+		reqs = append(reqs, *req)
+		res, pageRes := page(req)
+		got = append(got, res...)
+		return pageRes, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "c", "d", "e"}, got)
+	require.Len(t, reqs, 2)
+	require.True(t, reqs[0].CountTotal)
+	require.Equal(t, query.PageRequest{Key: []byte{3}, Limit: 2}, reqs[1])
+
+	errPage := errors.New("page error")
+	err = Paginate(context.Background(), nil, func(ctx context.Context, req *query.PageRequest) (*query.PageResponse, error) {
+		return nil, errPage
+	})
+	require.ErrorIs(t, err, errPage)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = Paginate(ctx, nil, func(ctx context.Context, req *query.PageRequest) (*query.PageResponse, error) {
+		t.Fatal("page queried with a canceled context")
+		return nil, nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+}
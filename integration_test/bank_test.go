@@ -10,6 +10,8 @@ import (
 	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	clientpkg "github.com/teleport-network/teleport-sdk-go/client"
 )
 
 func TestMsgSend(t *testing.T) {
@@ -45,3 +47,41 @@ func TestMsgSend(t *testing.T) {
 	assert.NoError(t, err)
 	fmt.Println(txRes.TxResponse.String())
 }
+
+func TestMsgSendAndWait(t *testing.T) {
+	client, err := newClient()
+	assert.NoError(t, err)
+
+	msg := types.MsgSend{
+		FromAddress: testAcc1.addr,
+		ToAddress:   "teleport199l57ddd3jepsu3rjen5snyd5x58y2qv9ydpja",
+		Amount:      sdk.NewCoins(sdk.NewCoin("atele", sdk.NewInt(10000000))),
+	}
+
+	txf, err := clientpkg.Prepare(client, msg.GetSigners()[0], &msg, func(txf sdktx.Factory) sdktx.Factory {
+		return txf.WithFees("100atele")
+	})
+	assert.NoError(t, err)
+
+	res, err := client.BroadcastAndWait(txf, &msg)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, res.Code)
+	assert.NotEmpty(t, res.Attributes("transfer", "recipient"))
+	fmt.Println(res.String())
+}
+
+func TestQueryBalances(t *testing.T) {
+	client, err := newClient()
+	assert.NoError(t, err)
+
+	balances, err := client.AllBalances(testAcc1.addr)
+	assert.NoError(t, err)
+
+	balance, err := client.Balance(testAcc1.addr, "atele")
+	assert.NoError(t, err)
+	assert.True(t, balances.AmountOf("atele").Equal(balance.Amount))
+
+	supply, err := client.TotalSupply()
+	assert.NoError(t, err)
+	fmt.Println(supply.String())
+}
@@ -0,0 +1,49 @@
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDelegate(t *testing.T) {
+	client, err := newClient()
+	assert.NoError(t, err)
+
+	validators, err := client.Validators(stakingtypes.Bonded.String())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, validators)
+
+	delegator, err := sdk.AccAddressFromBech32(testAcc1.addr)
+	assert.NoError(t, err)
+	validator, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	assert.NoError(t, err)
+
+	msg := stakingtypes.NewMsgDelegate(delegator, validator, sdk.NewCoin("atele", sdk.NewInt(10000000)))
+	res, err := client.Delegate(*msg)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, res.TxResponse.Code)
+	fmt.Println(res.String())
+
+	delegations, err := client.Delegations(testAcc1.addr)
+	assert.NoError(t, err)
+	fmt.Println(delegations.String())
+}
+
+func TestWithdrawAllRewards(t *testing.T) {
+	client, err := newClient()
+	assert.NoError(t, err)
+
+	rewards, total, err := client.DelegationTotalRewards(testAcc1.addr)
+	assert.NoError(t, err)
+	fmt.Println(rewards, total.String())
+
+	res, err := client.WithdrawAllRewards(testAcc1.addr)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, res.TxResponse.Code)
+	fmt.Println(res.String())
+}
@@ -0,0 +1,35 @@
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestTxBuilder(t *testing.T) {
+	client, err := newClient()
+	assert.NoError(t, err)
+
+	from, err := sdk.AccAddressFromBech32(testAcc1.addr)
+	assert.NoError(t, err)
+	to, err := sdk.AccAddressFromBech32("teleport199l57ddd3jepsu3rjen5snyd5x58y2qv9ydpja")
+	assert.NoError(t, err)
+
+	builder := client.NewTxBuilder(func(txf sdktx.Factory) sdktx.Factory {
+		return txf.WithFees("200atele")
+	}).AddMsgs(
+		banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewCoin("atele", sdk.NewInt(10000000)))),
+		govtypes.NewMsgVote(from, 1, govtypes.OptionYes),
+	)
+	assert.Equal(t, []sdk.AccAddress{from}, builder.Signers())
+
+	res, err := builder.BroadcastAndWait()
+	assert.NoError(t, err)
+	fmt.Println(res.String())
+}
@@ -34,6 +34,15 @@ func (ar *AccountRetriever) IncreaseSequence(addr sdk.AccAddress) {
 	}
 }
 
+// SetSequence sets the sequence of the cached account of addr, if it is cached.
+func (ar *AccountRetriever) SetSequence(addr sdk.AccAddress, sequence uint64) {
+	ar.mu.Lock()
+	defer ar.mu.Unlock()
+	if acc := ar.getFromCache(addr); acc != nil {
+		_ = acc.SetSequence(sequence)
+	}
+}
+
 func (ar *AccountRetriever) getFromCache(addr sdk.AccAddress) authtypes.AccountI {
 	if ar.Cache != nil {
 		v, err := ar.Cache.Get(addr.String())
@@ -49,13 +58,24 @@ func (ar *AccountRetriever) getFromCache(addr sdk.AccAddress) authtypes.AccountI
 }
 
 func (ar *AccountRetriever) GetAccount(clientCtx client.Context, addr sdk.AccAddress) (authtypes.AccountI, error) {
+	return ar.GetAccountCtx(context.Background(), clientCtx, addr)
+}
+
+// GetAccountCtx is like GetAccount but queries the node with the given context.
+func (ar *AccountRetriever) GetAccountCtx(ctx context.Context, clientCtx client.Context, addr sdk.AccAddress) (authtypes.AccountI, error) {
 	if acc := ar.getFromCache(addr); acc != nil {
 		return acc, nil
 	}
 
-	res, err := ar.QueryClient.AuthQuery.Account(context.Background(), &authtypes.QueryAccountRequest{Address: addr.String()})
+	return ar.QueryAccountCtx(ctx, clientCtx, addr)
+}
+
+// QueryAccountCtx queries the account of the given address from the node, bypassing the cache,
+// and caches the result.
+func (ar *AccountRetriever) QueryAccountCtx(ctx context.Context, clientCtx client.Context, addr sdk.AccAddress) (authtypes.AccountI, error) {
+	res, err := ar.QueryClient.AuthQuery.Account(ctx, &authtypes.QueryAccountRequest{Address: addr.String()})
 	if err != nil {
-		return nil, err
+		return nil, WrapNodeError(err)
 	}
 
 	var acc authtypes.AccountI
@@ -69,11 +89,16 @@ func (ar *AccountRetriever) GetAccount(clientCtx client.Context, addr sdk.AccAdd
 }
 
 func (ar *AccountRetriever) RefreshSequence(clientCtx client.Context) (authtypes.AccountI, error) {
+	return ar.RefreshSequenceCtx(context.Background(), clientCtx)
+}
+
+// RefreshSequenceCtx is like RefreshSequence but queries the node with the given context.
+func (ar *AccountRetriever) RefreshSequenceCtx(ctx context.Context, clientCtx client.Context) (authtypes.AccountI, error) {
 	from := clientCtx.GetFromAddress()
 
-	res, err := ar.QueryClient.AuthQuery.Account(context.Background(), &authtypes.QueryAccountRequest{Address: from.String()})
+	res, err := ar.QueryClient.AuthQuery.Account(ctx, &authtypes.QueryAccountRequest{Address: from.String()})
 	if err != nil {
-		return nil, err
+		return nil, WrapNodeError(err)
 	}
 
 	var acc authtypes.AccountI
@@ -91,7 +116,12 @@ func (ar *AccountRetriever) RefreshSequence(clientCtx client.Context) (authtypes
 
 // EnsureExists returns an error if no account exists for the given address else nil.
 func (ar *AccountRetriever) EnsureExists(clientCtx client.Context, addr sdk.AccAddress) error {
-	if _, err := ar.GetAccount(clientCtx, addr); err != nil {
+	return ar.EnsureExistsCtx(context.Background(), clientCtx, addr)
+}
+
+// EnsureExistsCtx is like EnsureExists but queries the node with the given context.
+func (ar *AccountRetriever) EnsureExistsCtx(ctx context.Context, clientCtx client.Context, addr sdk.AccAddress) error {
+	if _, err := ar.GetAccountCtx(ctx, clientCtx, addr); err != nil {
 		return err
 	}
 
@@ -101,7 +131,12 @@ func (ar *AccountRetriever) EnsureExists(clientCtx client.Context, addr sdk.AccA
 // GetAccountNumberSequence returns sequence and account number for the given address.
 // It returns an error if the account couldn't be retrieved from the state.
 func (ar *AccountRetriever) GetAccountNumberSequence(clientCtx client.Context, addr sdk.AccAddress) (uint64, uint64, error) {
-	acc, err := ar.GetAccount(clientCtx, addr)
+	return ar.GetAccountNumberSequenceCtx(context.Background(), clientCtx, addr)
+}
+
+// GetAccountNumberSequenceCtx is like GetAccountNumberSequence but queries the node with the given context.
+func (ar *AccountRetriever) GetAccountNumberSequenceCtx(ctx context.Context, clientCtx client.Context, addr sdk.AccAddress) (uint64, uint64, error) {
+	acc, err := ar.GetAccountCtx(ctx, clientCtx, addr)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Typed errors returned by the client. They can be checked with errors.Is against any error
+// returned from a broadcast, a simulation or a module helper.
+var (
+	ErrSequenceMismatch  = errors.New("account sequence mismatch")
+	ErrInsufficientFee   = errors.New("insufficient fee")
+	ErrOutOfGas          = errors.New("out of gas")
+	ErrTxInMempool       = errors.New("tx already in mempool")
+	ErrMempoolFull       = errors.New("mempool is full")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrChainIdMismatch   = errors.New("chain id mismatch")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrAccountNotFound   = errors.New("account not found")
+	ErrTxTooLarge        = errors.New("tx too large")
+	ErrUnavailable       = errors.New("node unavailable")
+	ErrTxTimeout         = errors.New("timed out waiting for tx to be committed")
+)
+
+// abciErrors maps the registered cosmos-sdk errors to the typed errors.
+var abciErrors = []struct {
+	abci *sdkerrors.Error
+	err  error
+}{
+	{sdkerrors.ErrWrongSequence, ErrSequenceMismatch},
+	{sdkerrors.ErrInvalidSequence, ErrSequenceMismatch}, // nonce mismatch of an ethereum tx
+	{sdkerrors.ErrInsufficientFee, ErrInsufficientFee},
+	{sdkerrors.ErrOutOfGas, ErrOutOfGas},
+	{sdkerrors.ErrTxInMempoolCache, ErrTxInMempool},
+	{sdkerrors.ErrMempoolIsFull, ErrMempoolFull},
+	{sdkerrors.ErrInsufficientFunds, ErrInsufficientFunds},
+	{sdkerrors.ErrInvalidChainID, ErrChainIdMismatch},
+	{sdkerrors.ErrUnknownAddress, ErrAccountNotFound},
+	{sdkerrors.ErrTxTooLarge, ErrTxTooLarge},
+	// a signature failure, which may come from a wrong chain id as well as a wrong account number
+	{sdkerrors.ErrUnauthorized, ErrUnauthorized},
+}
+
+// FromABCICode returns the typed error of the given codespace and code, or nil if there is none.
+func FromABCICode(codespace string, code uint32) error {
+	for _, e := range abciErrors {
+		if e.abci.Codespace() == codespace && e.abci.ABCICode() == code {
+			return e.err
+		}
+	}
+	return nil
+}
+
+// fromLog returns the typed error whose registered description appears in the given log, or nil if there is none.
+func fromLog(log string) error {
+	for _, e := range abciErrors {
+		if strings.Contains(log, e.abci.Error()) {
+			return e.err
+		}
+	}
+	return nil
+}
+
+// TxError is returned when a tx is rejected by the node, either in CheckTx or in DeliverTx.
+// It unwraps to the typed error of its codespace and code.
+type TxError struct {
+	TxHash    string
+	Height    int64
+	Codespace string
+	Code      uint32
+	Log       string
+}
+
+func NewTxError(res *sdk.TxResponse) *TxError {
+	return &TxError{
+		TxHash:    res.TxHash,
+		Height:    res.Height,
+		Codespace: res.Codespace,
+		Code:      res.Code,
+		Log:       res.RawLog,
+	}
+}
+
+func (e *TxError) Error() string {
+	return fmt.Sprintf("tx %s failed with code %d in codespace %s: %s", e.TxHash, e.Code, e.Codespace, e.Log)
+}
+
+func (e *TxError) Unwrap() error {
+	return FromABCICode(e.Codespace, e.Code)
+}
+
+// NodeError is a gRPC error returned by the node. It unwraps to the typed error parsed from
+// its status, and keeps the status itself for status.FromError and status.Code.
+type NodeError struct {
+	err  error
+	kind error
+}
+
+// WrapNodeError wraps the given gRPC error into a NodeError if it maps to a typed error,
+// otherwise it is returned as is.
+func WrapNodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	var kind error
+	switch s.Code() {
+	case codes.Unavailable:
+		kind = ErrUnavailable
+	case codes.NotFound:
+		if strings.Contains(s.Message(), "account") {
+			kind = ErrAccountNotFound
+		}
+	default:
+		kind = fromLog(s.Message())
+	}
+	if kind == nil {
+		return err
+	}
+	return &NodeError{err: err, kind: kind}
+}
+
+func (e *NodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *NodeError) Unwrap() error {
+	return e.kind
+}
+
+func (e *NodeError) GRPCStatus() *status.Status {
+	return status.Convert(e.err)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTxError(t *testing.T) {
+	err := error(NewTxError(&sdk.TxResponse{
+		Codespace: sdkerrors.RootCodespace,
+		Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+		RawLog:    "account sequence mismatch, expected 5, got 4: incorrect account sequence",
+	}))
+	require.True(t, errors.Is(err, ErrSequenceMismatch))
+	require.False(t, errors.Is(err, ErrOutOfGas))
+
+	var txErr *TxError
+	require.True(t, errors.As(err, &txErr))
+	require.EqualValues(t, 32, txErr.Code)
+	seq, ok := ExpectedSequence(err)
+	require.True(t, ok)
+	require.EqualValues(t, 5, seq)
+
+	// ethermint reports the nonce mismatch of an ethereum tx the other way around
+	err = NewTxError(&sdk.TxResponse{
+		Codespace: sdkerrors.RootCodespace,
+		Code:      sdkerrors.ErrInvalidSequence.ABCICode(),
+		RawLog:    "invalid nonce; got 4, expected 6: invalid sequence",
+	})
+	require.True(t, errors.Is(err, ErrSequenceMismatch))
+	seq, ok = ExpectedSequence(err)
+	require.True(t, ok)
+	require.EqualValues(t, 6, seq)
+
+	err = NewTxError(&sdk.TxResponse{
+		Codespace: sdkerrors.RootCodespace,
+		Code:      sdkerrors.ErrUnauthorized.ABCICode(),
+		RawLog:    "signature verification failed; please verify account number (1) and chain-id (teleport_9000-1): unauthorized",
+	})
+	// the node can not tell a wrong chain id from a wrong account number
+	require.True(t, errors.Is(err, ErrUnauthorized))
+	require.False(t, errors.Is(err, ErrChainIdMismatch))
+
+	err = NewTxError(&sdk.TxResponse{
+		Codespace: sdkerrors.RootCodespace,
+		Code:      sdkerrors.ErrInvalidChainID.ABCICode(),
+		RawLog:    "invalid chain-id on InitChain; expected: teleport_9000-1, got: teleport_8001-1: invalid chain-id",
+	})
+	require.True(t, errors.Is(err, ErrChainIdMismatch))
+
+	err = NewTxError(&sdk.TxResponse{Codespace: "bank", Code: 2})
+	require.Nil(t, errors.Unwrap(err))
+}
+
+func TestWrapNodeError(t *testing.T) {
+	testCases := []struct {
+		err  error
+		kind error
+	}{
+		{status.Error(codes.Unknown, "out of gas in location: WritePerByte; gasWanted: 100, gasUsed: 120: out of gas"), ErrOutOfGas},
+		{status.Error(codes.Unknown, "0atele is smaller than 100atele: insufficient funds"), ErrInsufficientFunds},
+		{status.Error(codes.Unavailable, "connection refused"), ErrUnavailable},
+		{status.Error(codes.NotFound, "account teleport1xyz not found"), ErrAccountNotFound},
+	}
+	for _, tc := range testCases {
+		err := WrapNodeError(tc.err)
+		require.True(t, errors.Is(err, tc.kind), tc.err.Error())
+		require.Equal(t, status.Code(tc.err), status.Code(err))
+		require.Equal(t, tc.err.Error(), err.Error())
+	}
+
+	err := status.Error(codes.Internal, "something else")
+	require.Equal(t, err, WrapNodeError(err))
+	require.Nil(t, WrapNodeError(nil))
+}
@@ -0,0 +1,213 @@
+package types
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// expectedSequenceRegexp matches the sequence expected by the node in a sequence mismatch log,
+// or in the nonce mismatch log of an ethereum tx.
+var expectedSequenceRegexp = regexp.MustCompile(`expected (\d+), got \d+|got \d+, expected (\d+)`)
+
+// SequenceManager allocates the account sequences of each signer. The txs of a signer are
+// signed and submitted one after another under the lock of the signer, so that many of them
+// can be in flight at once, while the txs of different signers proceed concurrently.
+type SequenceManager struct {
+	load func(ctx context.Context, clientCtx client.Context, addr sdk.AccAddress) (uint64, uint64, error)
+	// accounts, if set, has its cached sequences kept in step with the allocated ones
+	accounts *AccountRetriever
+
+	mu       sync.Mutex
+	signers  map[string]*signerSequence
+	disabled bool
+}
+
+type signerSequence struct {
+	mu            sync.Mutex
+	loaded        bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+func NewSequenceManager(ar *AccountRetriever) *SequenceManager {
+	return &SequenceManager{
+		load: func(ctx context.Context, clientCtx client.Context, addr sdk.AccAddress) (uint64, uint64, error) {
+			acc, err := ar.QueryAccountCtx(ctx, clientCtx, addr)
+			if err != nil {
+				return 0, 0, err
+			}
+			return acc.GetAccountNumber(), acc.GetSequence(), nil
+		},
+		accounts: ar,
+		signers:  make(map[string]*signerSequence),
+	}
+}
+
+// Enable keeps track of the sequences locally, which is the default.
+func (m *SequenceManager) Enable() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.disabled = false
+}
+
+// Disable makes every allocation load the sequence from the node.
+func (m *SequenceManager) Disable() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.disabled = true
+}
+
+func (m *SequenceManager) signer(addr sdk.AccAddress) (*signerSequence, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.signers[addr.String()]
+	if !ok {
+		s = &signerSequence{}
+		m.signers[addr.String()] = s
+	}
+	return s, m.disabled
+}
+
+func (m *SequenceManager) ensureLoaded(ctx context.Context, clientCtx client.Context, addr sdk.AccAddress, s *signerSequence, reload bool) error {
+	if s.loaded && !reload {
+		return nil
+	}
+	num, seq, err := m.load(ctx, clientCtx, addr)
+	if err != nil {
+		return err
+	}
+	s.loaded, s.accountNumber, s.sequence = true, num, seq
+	return nil
+}
+
+// Do calls fn with the account number and the next sequence of the signer while holding the
+// lock of the signer. The sequence is consumed only if fn reports it as used, otherwise it is
+// handed out again by the next call. A sequence mismatch resyncs the signer with the sequence
+// expected by the node, or with the account on chain if the expected one is unknown.
+func (m *SequenceManager) Do(
+	ctx context.Context, clientCtx client.Context, addr sdk.AccAddress,
+	fn func(accountNumber, sequence uint64) (used bool, err error),
+) error {
+	return m.DoAll(ctx, clientCtx, []sdk.AccAddress{addr}, func(accountNumbers, sequences []uint64) (bool, error) {
+		return fn(accountNumbers[0], sequences[0])
+	})
+}
+
+// DoAll is like Do for a tx of several distinct signers. The account numbers and sequences are passed to fn
+// in the order of addrs. On a sequence mismatch every signer is reloaded from chain, since the
+// node does not tell which signer is out of sync.
+func (m *SequenceManager) DoAll(
+	ctx context.Context, clientCtx client.Context, addrs []sdk.AccAddress,
+	fn func(accountNumbers, sequences []uint64) (used bool, err error),
+) error {
+	signers := make([]*signerSequence, len(addrs))
+	disabled := false
+	for i, addr := range addrs {
+		signers[i], disabled = m.signer(addr)
+	}
+
+	// lock the signers in address order so that overlapping calls can not deadlock
+	order := make([]int, len(addrs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return bytes.Compare(addrs[order[i]], addrs[order[j]]) < 0
+	})
+	for _, i := range order {
+		signers[i].mu.Lock()
+		defer signers[i].mu.Unlock()
+	}
+
+	accountNumbers := make([]uint64, len(addrs))
+	sequences := make([]uint64, len(addrs))
+	for i, s := range signers {
+		if err := m.ensureLoaded(ctx, clientCtx, addrs[i], s, disabled); err != nil {
+			return err
+		}
+		accountNumbers[i], sequences[i] = s.accountNumber, s.sequence
+	}
+
+	used, err := fn(accountNumbers, sequences)
+	for _, s := range signers {
+		if used {
+			s.sequence++
+		}
+	}
+	if errors.Is(err, ErrSequenceMismatch) {
+		seq, ok := ExpectedSequence(err)
+		for _, s := range signers {
+			if ok && len(signers) == 1 {
+				s.sequence = seq
+			} else {
+				s.loaded = false
+			}
+		}
+	}
+	for i, s := range signers {
+		m.syncCache(addrs[i], s)
+	}
+	return err
+}
+
+// syncCache keeps the cached account of the signer in step with its sequence, so that the
+// account retriever does not hand out a sequence that has already been used.
+func (m *SequenceManager) syncCache(addr sdk.AccAddress, s *signerSequence) {
+	if m.accounts == nil {
+		return
+	}
+	if s.loaded {
+		m.accounts.SetSequence(addr, s.sequence)
+	} else {
+		m.accounts.RemoveCache(addr)
+	}
+}
+
+// Peek returns the account number and the next sequence of the signer without allocating it.
+func (m *SequenceManager) Peek(ctx context.Context, clientCtx client.Context, addr sdk.AccAddress) (uint64, uint64, error) {
+	s, disabled := m.signer(addr)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := m.ensureLoaded(ctx, clientCtx, addr, s, disabled); err != nil {
+		return 0, 0, err
+	}
+	return s.accountNumber, s.sequence, nil
+}
+
+// Reset forgets the sequence of the signer, so that the next allocation loads it from the node.
+func (m *SequenceManager) Reset(addr sdk.AccAddress) {
+	s, _ := m.signer(addr)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loaded = false
+	m.syncCache(addr, s)
+}
+
+// ExpectedSequence parses the sequence expected by the node from a sequence mismatch error.
+func ExpectedSequence(err error) (uint64, bool) {
+	if err == nil {
+		return 0, false
+	}
+	match := expectedSequenceRegexp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0, false
+	}
+	expected := match[1]
+	if expected == "" {
+		expected = match[2]
+	}
+	seq, parseErr := strconv.ParseUint(expected, 10, 64)
+	if parseErr != nil {
+		return 0, false
+	}
+	return seq, true
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSequenceManager(chainSeq *uint64) *SequenceManager {
+	return &SequenceManager{
+		load: func(_ context.Context, _ client.Context, _ sdk.AccAddress) (uint64, uint64, error) {
+			return 7, *chainSeq, nil
+		},
+		signers: make(map[string]*signerSequence),
+	}
+}
+
+func TestSequenceManagerConcurrent(t *testing.T) {
+	chainSeq := uint64(10)
+	m := newTestSequenceManager(&chainSeq)
+	addr1, addr2 := sdk.AccAddress("addr1_______________"), sdk.AccAddress("addr2_______________")
+
+	// the goroutines only record their results, which are checked once they are all done
+	var mu sync.Mutex
+	used := map[string][]uint64{}
+	var accountNumbers []uint64
+	errs := make([]error, 50)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		addr := addr1
+		if i%2 == 0 {
+			addr = addr2
+		}
+		wg.Add(1)
+		go func(i int, addr sdk.AccAddress) {
+			defer wg.Done()
+			errs[i] = m.Do(context.Background(), client.Context{}, addr, func(num, seq uint64) (bool, error) {
+				mu.Lock()
+				accountNumbers = append(accountNumbers, num)
+				used[addr.String()] = append(used[addr.String()], seq)
+				mu.Unlock()
+				return true, nil
+			})
+		}(i, addr)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.Len(t, accountNumbers, 50)
+	for _, num := range accountNumbers {
+		require.EqualValues(t, 7, num)
+	}
+
+	for _, seqs := range used {
+		sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+		for i, seq := range seqs {
+			require.EqualValues(t, 10+i, seq)
+		}
+	}
+}
+
+func TestSequenceManagerRollback(t *testing.T) {
+	chainSeq := uint64(3)
+	m := newTestSequenceManager(&chainSeq)
+	addr := sdk.AccAddress("addr________________")
+	ctx := context.Background()
+
+	err := m.Do(ctx, client.Context{}, addr, func(_, seq uint64) (bool, error) {
+		require.EqualValues(t, 3, seq)
+		return false, errors.New("broadcast failed")
+	})
+	require.Error(t, err)
+	_, seq, err := m.Peek(ctx, client.Context{}, addr)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, seq)
+
+	// the node reports the sequence it expects
+	err = m.Do(ctx, client.Context{}, addr, func(_, seq uint64) (bool, error) {
+		return false, NewTxError(&sdk.TxResponse{
+			Codespace: sdkerrors.RootCodespace,
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			RawLog:    fmt.Sprintf("account sequence mismatch, expected 5, got %d: incorrect account sequence", seq),
+		})
+	})
+	require.True(t, errors.Is(err, ErrSequenceMismatch))
+	_, seq, _ = m.Peek(ctx, client.Context{}, addr)
+	require.EqualValues(t, 5, seq)
+
+	// without the expected sequence, the signer is reloaded from chain
+	chainSeq = 9
+	_ = m.Do(ctx, client.Context{}, addr, func(_, _ uint64) (bool, error) {
+		return false, ErrSequenceMismatch
+	})
+	_, seq, _ = m.Peek(ctx, client.Context{}, addr)
+	require.EqualValues(t, 9, seq)
+}